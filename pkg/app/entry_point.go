@@ -14,8 +14,12 @@ import (
 	"github.com/integrii/flaggy"
 	"github.com/jesseduffield/lazygit/pkg/app/daemon"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/cheatsheet"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/env"
+	"github.com/jesseduffield/lazygit/pkg/gui"
 	integrationTypes "github.com/jesseduffield/lazygit/pkg/integration/types"
 	"github.com/jesseduffield/lazygit/pkg/logs/tail"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -32,6 +36,7 @@ type cliArgs struct {
 	TailLogs           bool
 	PrintDefaultConfig bool
 	PrintConfigDir     bool
+	PrintKeybindings   string
 	UseConfigDir       string
 	WorkTree           string
 	GitDir             string
@@ -140,6 +145,15 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 		log.Fatal(err)
 	}
 
+	if cliArgs.PrintKeybindings != "" {
+		content, err := formatKeybindings(appConfig, common, cliArgs.PrintKeybindings)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		fmt.Println(content)
+		os.Exit(0)
+	}
+
 	if daemon.InDaemonMode() {
 		daemon.Handle(common)
 		return
@@ -177,6 +191,9 @@ func parseCliArgsAndEnvVars() *cliArgs {
 	printConfigDir := false
 	flaggy.Bool(&printConfigDir, "cd", "print-config-dir", "Print the config directory")
 
+	printKeybindings := ""
+	flaggy.String(&printKeybindings, "pk", "print-keybindings", "Print the keybindings of your config, including custom commands, as 'markdown' or 'json'")
+
 	useConfigDir := ""
 	flaggy.String(&useConfigDir, "ucd", "use-config-dir", "override default config directory with provided directory")
 
@@ -204,6 +221,7 @@ func parseCliArgsAndEnvVars() *cliArgs {
 		TailLogs:           tailLogs,
 		PrintDefaultConfig: printDefaultConfig,
 		PrintConfigDir:     printConfigDir,
+		PrintKeybindings:   printKeybindings,
 		UseConfigDir:       useConfigDir,
 		WorkTree:           workTree,
 		GitDir:             gitDir,
@@ -281,3 +299,24 @@ func getGitVersionInfo() string {
 	gitVersion := strings.Trim(strings.TrimPrefix(string(stdout), "git version "), " \r\n")
 	return gitVersion
 }
+
+func formatKeybindings(appConfig config.AppConfigurer, cmn *common.Common, format string) (string, error) {
+	if format != "markdown" && format != "json" {
+		return "", fmt.Errorf("Invalid keybindings format '%s'. Must be 'markdown' or 'json'", format)
+	}
+
+	mGui, err := gui.NewGui(cmn, appConfig, &git_commands.GitVersion{}, nil, false, "")
+	if err != nil {
+		return "", err
+	}
+
+	bindings, err := mGui.GetConfiguredKeybindings()
+	if err != nil {
+		return "", err
+	}
+
+	if format == "json" {
+		return cheatsheet.FormatJSON(cmn.Tr, bindings)
+	}
+	return cheatsheet.FormatMarkdown(cmn.Tr, bindings), nil
+}
@@ -0,0 +1,269 @@
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The audit log records every action and git command that lazygit shows in its
+// command log, so that you can find out afterwards what was run. It's a file
+// with one JSON object per line in the config dir, which is rotated once it
+// grows beyond a configured size. Unlike the command log panel, it's shared by
+// all lazygit processes and kept across sessions.
+
+// The name of the audit log file in the config dir. Rotated files get a
+// numbered suffix, e.g. 'audit.log.1' is the most recent rotated file.
+const FileName = "audit.log"
+
+type EntryKind string
+
+const (
+	// An action like 'Stage file', which groups the commands that follow it
+	ActionEntry EntryKind = "action"
+	// A command that lazygit ran
+	CommandEntry EntryKind = "command"
+)
+
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Identifies the lazygit process that wrote the entry
+	Session  string    `json:"session"`
+	RepoPath string    `json:"repo"`
+	Kind     EntryKind `json:"kind"`
+	Text     string    `json:"text"`
+	// Only set for commands. -1 if the command couldn't be started or was
+	// killed.
+	ExitCode *int `json:"exitCode,omitempty"`
+	// Only set for commands
+	DurationMs int64 `json:"durationMs,omitempty"`
+}
+
+func (self Entry) IsCommand() bool {
+	return self.Kind == CommandEntry
+}
+
+func (self Entry) Duration() time.Duration {
+	return time.Duration(self.DurationMs) * time.Millisecond
+}
+
+type Logger struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	session  string
+
+	mutex sync.Mutex
+	log   *logrus.Entry
+}
+
+// Returns a logger that appends to the file at path, rotating it once it's
+// bigger than maxSize bytes and keeping at most maxFiles rotated files.
+func New(log *logrus.Entry, path string, maxSize int64, maxFiles int) *Logger {
+	return &Logger{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		session:  fmt.Sprintf("%s-%d", time.Now().Format("20060102T150405"), os.Getpid()),
+		log:      log,
+	}
+}
+
+// Returns a logger that doesn't record anything, for when the audit log is
+// disabled
+func NewNullLogger() *Logger {
+	return &Logger{}
+}
+
+func (self *Logger) LogAction(action string) {
+	self.append(Entry{Kind: ActionEntry, Text: action})
+}
+
+func (self *Logger) LogCommand(cmdStr string, exitCode int, duration time.Duration) {
+	self.append(Entry{
+		Kind:       CommandEntry,
+		Text:       cmdStr,
+		ExitCode:   &exitCode,
+		DurationMs: duration.Milliseconds(),
+	})
+}
+
+func (self *Logger) append(entry Entry) {
+	if self.path == "" {
+		return
+	}
+
+	entry.Time = time.Now()
+	entry.Session = self.session
+	// lazygit changes into the directory of the repo it's showing
+	entry.RepoPath, _ = os.Getwd()
+
+	if err := self.write(entry); err != nil {
+		self.log.Errorf("Failed to write to audit log: %v", err)
+	}
+}
+
+func (self *Logger) write(entry Entry) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if err := self.rotateIfNeeded(); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(self.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+func (self *Logger) rotateIfNeeded() error {
+	info, err := os.Stat(self.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if info.Size() < self.maxSize {
+		return nil
+	}
+
+	if self.maxFiles < 1 {
+		return os.Remove(self.path)
+	}
+
+	_ = os.Remove(rotatedPath(self.path, self.maxFiles))
+	for i := self.maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(rotatedPath(self.path, i), rotatedPath(self.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(self.path, rotatedPath(self.path, 1))
+}
+
+func rotatedPath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+// Returns the entries of the log at path and of up to maxFiles rotated files,
+// oldest first. Lines that can't be parsed are skipped.
+func Read(path string, maxFiles int) ([]Entry, error) {
+	paths := []string{}
+	for i := maxFiles; i >= 1; i-- {
+		paths = append(paths, rotatedPath(path, i))
+	}
+	paths = append(paths, path)
+
+	entries := []Entry{}
+	for _, path := range paths {
+		fileEntries, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	return entries, nil
+}
+
+func readFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+type Session struct {
+	ID      string
+	Entries []Entry
+}
+
+func (self *Session) Start() time.Time {
+	return self.Entries[0].Time
+}
+
+// The repos that entries were recorded for, in the order they were first seen
+func (self *Session) RepoPaths() []string {
+	return lo.Uniq(lo.Map(self.Entries, func(entry Entry, _ int) string {
+		return entry.RepoPath
+	}))
+}
+
+// Groups the entries by the lazygit process that wrote them, most recent
+// session first. Sessions of processes that ran at the same time can be
+// interleaved in the log, so this doesn't rely on their order.
+func GroupBySession(entries []Entry) []*Session {
+	sessionsByID := map[string]*Session{}
+	sessions := []*Session{}
+	for _, entry := range entries {
+		session, ok := sessionsByID[entry.Session]
+		if !ok {
+			session = &Session{ID: entry.Session}
+			sessionsByID[entry.Session] = session
+			sessions = append(sessions, session)
+		}
+		session.Entries = append(session.Entries, entry)
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Start().After(sessions[j].Start())
+	})
+
+	return sessions
+}
+
+// Returns the entries with the groups of an action and the commands that
+// follow it in reverse order, so that the most recent action comes first but
+// is still followed by its commands. A command belongs to the most recent
+// action of its own session, since sessions can be interleaved.
+func NewestActionsFirst(entries []Entry) []Entry {
+	groups := [][]Entry{}
+	groupIndexBySession := map[string]int{}
+	for _, entry := range entries {
+		index, ok := groupIndexBySession[entry.Session]
+		if !entry.IsCommand() || !ok {
+			index = len(groups)
+			groups = append(groups, nil)
+			groupIndexBySession[entry.Session] = index
+		}
+		groups[index] = append(groups[index], entry)
+	}
+
+	return lo.Flatten(lo.Reverse(groups))
+}
@@ -0,0 +1,135 @@
+package auditlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	logger := New(utils.NewDummyLog(), path, 1024*1024, 2)
+
+	logger.LogAction("Stage file")
+	logger.LogCommand("git add -- file", 0, 1500*time.Millisecond)
+	logger.LogCommand("git push", 1, 0)
+
+	entries, err := Read(path, 2)
+	assert.NoError(t, err)
+
+	cwd, _ := os.Getwd()
+	assert.Len(t, entries, 3)
+	for _, entry := range entries {
+		assert.Equal(t, logger.session, entry.Session)
+		assert.Equal(t, cwd, entry.RepoPath)
+	}
+
+	assert.Equal(t, ActionEntry, entries[0].Kind)
+	assert.Equal(t, "Stage file", entries[0].Text)
+	assert.Nil(t, entries[0].ExitCode)
+
+	assert.Equal(t, CommandEntry, entries[1].Kind)
+	assert.Equal(t, "git add -- file", entries[1].Text)
+	assert.Equal(t, 0, *entries[1].ExitCode)
+	assert.Equal(t, 1500*time.Millisecond, entries[1].Duration())
+
+	assert.Equal(t, 1, *entries[2].ExitCode)
+}
+
+func TestNullLogger(t *testing.T) {
+	dir := t.TempDir()
+	logger := NewNullLogger()
+
+	logger.LogAction("Stage file")
+	logger.LogCommand("git add -- file", 0, time.Second)
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	// every entry is bigger than this, so each one ends up in its own file
+	logger := New(utils.NewDummyLog(), path, 10, 2)
+
+	for _, action := range []string{"one", "two", "three", "four"} {
+		logger.LogAction(action)
+	}
+
+	// the oldest entry has been dropped
+	entries, err := Read(path, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"two", "three", "four"}, lo.Map(entries, func(entry Entry, _ int) string {
+		return entry.Text
+	}))
+
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReadSkipsMalformedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	content := `{"time":"2024-01-01T10:00:00Z","session":"a","kind":"action","text":"Stage file"}
+not json
+{"time":"2024-01-01T10:00:01Z","session":"a","kind":"command","text":"git add","exitCode":0}
+`
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	entries, err := Read(path, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Stage file", "git add"}, lo.Map(entries, func(entry Entry, _ int) string {
+		return entry.Text
+	}))
+}
+
+func TestGroupBySession(t *testing.T) {
+	at := func(minute int) time.Time {
+		return time.Date(2024, 1, 1, 10, minute, 0, 0, time.UTC)
+	}
+
+	// the sessions of two processes running at the same time are interleaved
+	entries := []Entry{
+		{Time: at(0), Session: "a", RepoPath: "/repo1", Text: "a1"},
+		{Time: at(1), Session: "b", RepoPath: "/repo2", Text: "b1"},
+		{Time: at(2), Session: "a", RepoPath: "/repo3", Text: "a2"},
+		{Time: at(3), Session: "a", RepoPath: "/repo1", Text: "a3"},
+		{Time: at(4), Session: "c", RepoPath: "/repo1", Text: "c1"},
+	}
+
+	sessions := GroupBySession(entries)
+
+	assert.Equal(t, []string{"c", "b", "a"}, lo.Map(sessions, func(session *Session, _ int) string {
+		return session.ID
+	}))
+	assert.Equal(t, at(0), sessions[2].Start())
+	assert.Equal(t, []string{"/repo1", "/repo3"}, sessions[2].RepoPaths())
+	assert.Equal(t, []string{"a1", "a2", "a3"}, lo.Map(sessions[2].Entries, func(entry Entry, _ int) string {
+		return entry.Text
+	}))
+}
+
+func TestNewestActionsFirst(t *testing.T) {
+	entries := []Entry{
+		{Session: "a", Kind: CommandEntry, Text: "a-orphan"},
+		{Session: "a", Kind: ActionEntry, Text: "a-action1"},
+		{Session: "a", Kind: CommandEntry, Text: "a-command1"},
+		{Session: "b", Kind: ActionEntry, Text: "b-action1"},
+		{Session: "a", Kind: CommandEntry, Text: "a-command2"},
+		{Session: "b", Kind: CommandEntry, Text: "b-command1"},
+		{Session: "a", Kind: ActionEntry, Text: "a-action2"},
+		{Session: "a", Kind: CommandEntry, Text: "a-command3"},
+	}
+
+	assert.Equal(t,
+		[]string{"a-action2", "a-command3", "b-action1", "b-command1", "a-action1", "a-command1", "a-command2", "a-orphan"},
+		lo.Map(NewestActionsFirst(entries), func(entry Entry, _ int) string {
+			return entry.Text
+		}),
+	)
+}
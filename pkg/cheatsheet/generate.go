@@ -7,19 +7,19 @@
 //
 // To generate the cheatsheets, run:
 //   go generate pkg/cheatsheet/generate.go
+//
+// The same formatting is used by `lazygit --print-keybindings`.
 
 package cheatsheet
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
-	"log"
-	"os"
 	"strings"
 
 	"github.com/jesseduffield/generics/maps"
 	"github.com/jesseduffield/lazycore/pkg/utils"
-	"github.com/jesseduffield/lazygit/pkg/app"
-	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
@@ -51,41 +51,51 @@ func GetKeybindingsDir() string {
 	return utils.GetLazyRootDirectory() + "/docs/keybindings"
 }
 
-func generateAtDir(cheatsheetDir string) {
-	translationSetsByLang := i18n.GetTranslationSets()
-	mConfig := config.NewDummyAppConfig()
-
-	for lang := range translationSetsByLang {
-		mConfig.GetUserConfig().Gui.Language = lang
-		common, err := app.NewCommon(mConfig)
-		if err != nil {
-			log.Fatal(err)
-		}
-		mApp, _ := app.NewApp(mConfig, common)
-		path := cheatsheetDir + "/Keybindings_" + lang + ".md"
-		file, err := os.Create(path)
-		if err != nil {
-			panic(err)
-		}
+// The keybindings grouped into sections as in the cheatsheets, without the
+// note about the file being generated
+func FormatMarkdown(tr *i18n.TranslationSet, bindings []*types.Binding) string {
+	return formatSections(tr, getBindingSections(bindings, tr))
+}
 
-		bindings := mApp.Gui.GetCheatsheetKeybindings()
-		bindingSections := getBindingSections(bindings, mApp.Tr)
-		content := formatSections(mApp.Tr, bindingSections)
-		content = fmt.Sprintf("_This file is auto-generated. To update, make the changes in the "+
-			"pkg/i18n directory and then run `%s` from the project root._\n\n%s", CommandToRun(), content)
-		writeString(file, content)
-	}
+type jsonSection struct {
+	Title    string        `json:"title"`
+	Bindings []jsonBinding `json:"bindings"`
 }
 
-func Generate() {
-	generateAtDir(GetKeybindingsDir())
+type jsonBinding struct {
+	// empty for global keybindings
+	View        string `json:"view"`
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Alternative string `json:"alternative,omitempty"`
 }
 
-func writeString(file *os.File, str string) {
-	_, err := file.WriteString(str)
-	if err != nil {
-		log.Fatal(err)
+// The same sections as FormatMarkdown, for consumption by other tools
+func FormatJSON(tr *i18n.TranslationSet, bindings []*types.Binding) (string, error) {
+	sections := lo.Map(getBindingSections(bindings, tr), func(section *bindingSection, _ int) jsonSection {
+		return jsonSection{
+			Title: section.title,
+			Bindings: lo.Map(section.bindings, func(binding *types.Binding, _ int) jsonBinding {
+				return jsonBinding{
+					View:        binding.ViewName,
+					Key:         keybindings.LabelFromKey(binding.Key),
+					Description: binding.Description,
+					Alternative: binding.Alternative,
+				}
+			}),
+		}
+	})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	// keys like <c-r> would otherwise be escaped
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(sections); err != nil {
+		return "", err
 	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
 }
 
 func localisedTitle(tr *i18n.TranslationSet, str string) string {
@@ -3,6 +3,7 @@ package cheatsheet
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/stretchr/testify/assert"
@@ -267,3 +268,34 @@ func TestGetBindingSections(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatJSON(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+
+	bindings := []*types.Binding{
+		{
+			ViewName:    "",
+			Description: "Quit",
+			Key:         'q',
+		},
+		{
+			ViewName:    "files",
+			Description: "Commit",
+			Key:         keybindings.GetKey("<space> c"),
+			Alternative: "C",
+		},
+		{
+			ViewName: "files",
+			Key:      'x',
+		},
+	}
+
+	actual, err := FormatJSON(&tr, bindings)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"title": "Global keybindings", "bindings": [{"view": "", "key": "q", "description": "Quit"}]},
+		{"title": "Files", "bindings": [{"view": "files", "key": "<space> c", "description": "Commit", "alternative": "C"}]}
+	]`, actual)
+	// not escaped as \u003cspace\u003e
+	assert.Contains(t, actual, `"key": "<space> c"`)
+}
@@ -4,11 +4,48 @@ package main
 
 import (
 	"fmt"
+	"log"
+	"os"
 
+	"github.com/jesseduffield/lazygit/pkg/app"
 	"github.com/jesseduffield/lazygit/pkg/cheatsheet"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 )
 
 func main() {
 	fmt.Printf("Generating cheatsheets in %s...\n", cheatsheet.GetKeybindingsDir())
-	cheatsheet.Generate()
+	generateAtDir(cheatsheet.GetKeybindingsDir())
+}
+
+func generateAtDir(cheatsheetDir string) {
+	translationSetsByLang := i18n.GetTranslationSets()
+	mConfig := config.NewDummyAppConfig()
+
+	for lang := range translationSetsByLang {
+		mConfig.GetUserConfig().Gui.Language = lang
+		common, err := app.NewCommon(mConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		mApp, _ := app.NewApp(mConfig, common)
+		path := cheatsheetDir + "/Keybindings_" + lang + ".md"
+		file, err := os.Create(path)
+		if err != nil {
+			panic(err)
+		}
+
+		bindings := mApp.Gui.GetCheatsheetKeybindings()
+		content := cheatsheet.FormatMarkdown(mApp.Tr, bindings)
+		content = fmt.Sprintf("_This file is auto-generated. To update, make the changes in the "+
+			"pkg/i18n directory and then run `%s` from the project root._\n\n%s", cheatsheet.CommandToRun(), content)
+		writeString(file, content)
+	}
+}
+
+func writeString(file *os.File, str string) {
+	_, err := file.WriteString(str)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -28,9 +28,11 @@ type GitCommand struct {
 	Diff        *git_commands.DiffCommands
 	File        *git_commands.FileCommands
 	Flow        *git_commands.FlowCommands
+	Lfs         *git_commands.LfsCommands
 	Patch       *git_commands.PatchCommands
 	Rebase      *git_commands.RebaseCommands
 	Remote      *git_commands.RemoteCommands
+	Signing     *git_commands.SigningCommands
 	Stash       *git_commands.StashCommands
 	Status      *git_commands.StatusCommands
 	Submodule   *git_commands.SubmoduleCommands
@@ -141,6 +143,7 @@ func NewGitCommandAux(
 	fileLoader := git_commands.NewFileLoader(gitCommon, cmd, configCommands)
 	statusCommands := git_commands.NewStatusCommands(gitCommon)
 	flowCommands := git_commands.NewFlowCommands(gitCommon)
+	lfsCommands := git_commands.NewLfsCommands(gitCommon)
 	remoteCommands := git_commands.NewRemoteCommands(gitCommon)
 	branchCommands := git_commands.NewBranchCommands(gitCommon)
 	syncCommands := git_commands.NewSyncCommands(gitCommon)
@@ -160,6 +163,7 @@ func NewGitCommandAux(
 	patchCommands := git_commands.NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, patchBuilder)
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
+	signingCommands := git_commands.NewSigningCommands(gitCommon)
 
 	branchLoader := git_commands.NewBranchLoader(cmn, cmd, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
@@ -178,9 +182,11 @@ func NewGitCommandAux(
 		Diff:        diffCommands,
 		File:        fileCommands,
 		Flow:        flowCommands,
+		Lfs:         lfsCommands,
 		Patch:       patchCommands,
 		Rebase:      rebaseCommands,
 		Remote:      remoteCommands,
+		Signing:     signingCommands,
 		Stash:       stashCommands,
 		Status:      statusCommands,
 		Submodule:   submoduleCommands,
@@ -7,6 +7,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/mgutz/str"
+	"github.com/samber/lo"
 )
 
 type BranchCommands struct {
@@ -28,6 +29,16 @@ func (self *BranchCommands) New(name string, base string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// NewWithoutCheckout creates a branch at the given commit without checking it
+// out, e.g. to restore a deleted branch
+func (self *BranchCommands) NewWithoutCheckout(name string, sha string) error {
+	cmdArgs := NewGitCmd("branch").
+		Arg(name, sha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // CurrentBranchInfo get the current branch information.
 func (self *BranchCommands) CurrentBranchInfo() (BranchInfo, error) {
 	branchName, err := self.cmd.New(
@@ -188,6 +199,24 @@ func (self *BranchCommands) countDifferences(from, to string) (string, error) {
 	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 }
 
+// Returns the commits of the branch's upstream that the branch doesn't contain,
+// newest first, as '<short sha> <subject>' lines
+func (self *BranchCommands) GetIncomingCommits(branchName string) ([]string, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--oneline", "--no-decorate").
+		Arg(fmt.Sprintf("%s..%s@{u}", branchName, branchName)).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(utils.SplitLines(output), func(line string, _ int) bool {
+		return strings.TrimSpace(line) != ""
+	}), nil
+}
+
 func (self *BranchCommands) IsHeadDetached() bool {
 	cmdArgs := NewGitCmd("symbolic-ref").Arg("-q", "HEAD").ToArgv()
 
@@ -221,3 +250,131 @@ func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
 func (self *BranchCommands) AllBranchesLogCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(str.ToArgv(self.UserConfig.Git.AllBranchesLogCmd)).DontLog()
 }
+
+// MainBranchRefs returns the full refs of the configured main branches that
+// exist in the repo. For each main branch this includes the local branch as
+// well as its upstream (or its counterpart on origin).
+func (self *BranchCommands) MainBranchRefs() []string {
+	refs := existingMainBranches(self.cmd, self.UserConfig.Git.MainBranches)
+
+	for _, branchName := range self.UserConfig.Git.MainBranches {
+		ref := "refs/heads/" + branchName
+		if lo.Contains(refs, ref) {
+			continue
+		}
+		if err := self.cmd.New(
+			NewGitCmd("rev-parse").Arg("--verify", "--quiet", ref).ToArgv(),
+		).DontLog().Run(); err == nil {
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// MergedBranches returns the names of the local branches whose tip is reachable
+// from the given ref
+func (self *BranchCommands) MergedBranches(ref string) ([]string, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--merged", ref, "--format=%(refname)", "refs/heads/").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(utils.SplitLines(output), func(line string, _ int) string {
+		return strings.TrimPrefix(strings.TrimSpace(line), "refs/heads/")
+	}), nil
+}
+
+// IsSquashMerged tells us whether the changes of a local branch have made it
+// into the given ref even though the branch itself isn't merged into it, e.g.
+// because the branch was squash-merged or rebased onto it.
+func (self *BranchCommands) IsSquashMerged(branchName string, ref string) bool {
+	branchRef := "refs/heads/" + branchName
+
+	// If every commit on the branch has a patch-equivalent commit in ref, the
+	// branch was rebased or cherry-picked onto it.
+	cherryOutput, err := self.cherry(ref, branchRef)
+	if err != nil {
+		return false
+	}
+	if !strings.Contains(cherryOutput, "+") {
+		return true
+	}
+
+	// Otherwise, compare the patch-id of all of the branch's changes since the
+	// merge base with the patch-ids of the commits that ref has gained since
+	// then. Unlike cherry-picking a squashed commit, this doesn't write any
+	// objects to the repo.
+	mergeBase, err := self.cmd.New(
+		NewGitCmd("merge-base").Arg(ref, branchRef).ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+	mergeBase = strings.TrimSpace(mergeBase)
+
+	squashedDiff, err := self.cmd.New(
+		NewGitCmd("diff").Arg("--no-color", "--no-ext-diff", mergeBase, branchRef).ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+	squashedPatchIDs, err := self.patchIDs(squashedDiff)
+	if err != nil || len(squashedPatchIDs) != 1 {
+		return false
+	}
+
+	refLog, err := self.cmd.New(
+		NewGitCmd("log").
+			Arg("-p", "--no-color", "--no-ext-diff", "--no-merges", "--format=commit %H", mergeBase+".."+ref).
+			ToArgv(),
+	).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+	refPatchIDs, err := self.patchIDs(refLog)
+	if err != nil {
+		return false
+	}
+	return lo.Contains(refPatchIDs, squashedPatchIDs[0])
+}
+
+// Returns the patch-id of each patch in the given diff or log output
+func (self *BranchCommands) patchIDs(patches string) ([]string, error) {
+	if strings.TrimSpace(patches) == "" {
+		return nil, nil
+	}
+
+	cmdObj := self.cmd.New(NewGitCmd("patch-id").Arg("--stable").ToArgv()).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(patches)
+	output, err := cmdObj.RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(utils.SplitLines(output), func(line string, _ int) string {
+		return strings.Fields(line)[0]
+	}), nil
+}
+
+// Output has one line per commit in head that isn't in upstream, prefixed
+// with '-' if upstream contains an equivalent commit and with '+' otherwise
+func (self *BranchCommands) cherry(upstream string, head string) (string, error) {
+	return self.cmd.New(
+		NewGitCmd("cherry").Arg(upstream, head).ToArgv(),
+	).DontLog().RunWithOutput()
+}
+
+// LocalDeleteMultiple deletes several local branches at once
+func (self *BranchCommands) LocalDeleteMultiple(branchNames []string, force bool) error {
+	cmdArgs := NewGitCmd("branch").
+		ArgIfElse(force, "-D", "-d").
+		Arg(branchNames...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -61,6 +61,15 @@ func TestBranchNewBranch(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestBranchNewWithoutCheckout(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"branch", "test", "abc123"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.NewWithoutCheckout("test", "abc123"))
+	runner.CheckForMissingCalls()
+}
+
 func TestBranchDeleteBranch(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -274,3 +283,102 @@ func TestBranchCurrentBranchInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchMainBranchRefs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master\n", nil).
+		ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/heads/master"}, "", nil)
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.MainBranches = []string{"master"}
+	instance := buildBranchCommands(commonDeps{runner: runner, userConfig: userConfig})
+
+	assert.EqualValues(t, []string{"refs/remotes/origin/master", "refs/heads/master"}, instance.MainBranchRefs())
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchMergedBranches(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--merged", "refs/heads/master", "--format=%(refname)", "refs/heads/"},
+			"refs/heads/feature/one\nrefs/heads/master\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	branches, err := instance.MergedBranches("refs/heads/master")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"feature/one", "master"}, branches)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchIsSquashMerged(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "all commits cherry-picked onto main branch",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cherry", "master", "refs/heads/feature"}, "- abc\n- def\n", nil),
+			expected: true,
+		},
+		{
+			testName: "squash-merged into main branch",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cherry", "master", "refs/heads/feature"}, "+ abc\n+ def\n", nil).
+				ExpectGitArgs([]string{"merge-base", "master", "refs/heads/feature"}, "123\n", nil).
+				ExpectGitArgs([]string{"diff", "--no-color", "--no-ext-diff", "123", "refs/heads/feature"}, "diff --git a/file b/file\n", nil).
+				ExpectGitArgs([]string{"patch-id", "--stable"}, "aaa 0000000\n", nil).
+				ExpectGitArgs([]string{"log", "-p", "--no-color", "--no-ext-diff", "--no-merges", "--format=commit %H", "123..master"}, "commit 456\ndiff --git a/file b/file\n", nil).
+				ExpectGitArgs([]string{"patch-id", "--stable"}, "bbb 789\naaa 456\n", nil),
+			expected: true,
+		},
+		{
+			testName: "not merged",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cherry", "master", "refs/heads/feature"}, "- abc\n+ def\n", nil).
+				ExpectGitArgs([]string{"merge-base", "master", "refs/heads/feature"}, "123\n", nil).
+				ExpectGitArgs([]string{"diff", "--no-color", "--no-ext-diff", "123", "refs/heads/feature"}, "diff --git a/file b/file\n", nil).
+				ExpectGitArgs([]string{"patch-id", "--stable"}, "aaa 0000000\n", nil).
+				ExpectGitArgs([]string{"log", "-p", "--no-color", "--no-ext-diff", "--no-merges", "--format=commit %H", "123..master"}, "commit 456\ndiff --git a/file b/file\n", nil).
+				ExpectGitArgs([]string{"patch-id", "--stable"}, "bbb 456\n", nil),
+			expected: false,
+		},
+		{
+			testName: "error",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cherry", "master", "refs/heads/feature"}, "", errors.New("error")),
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+			assert.Equal(t, s.expected, instance.IsSquashMerged("feature", "master"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestBranchLocalDeleteMultiple(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"branch", "-D", "one", "two"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.LocalDeleteMultiple([]string{"one", "two"}, true))
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetIncomingCommits(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--oneline", "--no-decorate", "master..master@{u}"},
+			"abc1234 three\ndef5678 two\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	commits, err := instance.GetIncomingCommits("master")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"abc1234 three", "def5678 two"}, commits)
+	runner.CheckForMissingCalls()
+}
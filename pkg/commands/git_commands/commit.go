@@ -224,9 +224,12 @@ func (self *CommitCommands) ShowCmdObj(sha string, filterPath string) oscommands
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(self.UserConfig.Git.Log.ShowSignatureStatus, "--show-signature").
 		Arg("-p").
 		Arg(sha).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(self.diffOptionArgs(false)...).
+		Arg(self.renameDetectionArgs()...).
 		ArgIf(filterPath != "", "--", filterPath).
 		ToArgv()
 
@@ -28,6 +28,8 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 		Arg("--no-ext-diff").
 		Arg("--name-status").
 		Arg("-z").
+		// not renameDetectionArgs: the output is parsed as status/path pairs,
+		// and custom patches are built per path
 		Arg("--no-renames").
 		ArgIf(reverse, "-R").
 		Arg(from).
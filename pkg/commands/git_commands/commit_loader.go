@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
@@ -71,6 +72,8 @@ type GetCommitsOptions struct {
 	All bool
 	// If non-empty, show divergence from this ref (left-right log)
 	RefToShowDivergenceFrom string
+	// If set, the git log command is terminated when the task is cancelled
+	Task gocui.Task
 }
 
 // GetCommits obtains the commits of the current branch
@@ -202,7 +205,7 @@ func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*mod
 // example input:
 // 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|refresh commits when adding a tag
 func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool) *models.Commit {
-	split := strings.SplitN(line, "\x00", 8)
+	split := strings.SplitN(line, "\x00", 10)
 
 	sha := split[0]
 	unixTimestamp := split[1]
@@ -215,6 +218,12 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 	if showDivergence {
 		divergence = lo.Ternary(split[7] == "<", models.DivergenceLeft, models.DivergenceRight)
 	}
+	signatureStatus := models.SignatureNotLoaded
+	signer := ""
+	if len(split) == 10 {
+		signatureStatus = models.SignatureStatus(split[8])
+		signer = split[9]
+	}
 
 	tags := []string{}
 
@@ -249,6 +258,9 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 		AuthorEmail:   authorEmail,
 		Parents:       parents,
 		Divergence:    divergence,
+
+		SignatureStatus: signatureStatus,
+		Signer:          signer,
 	}
 }
 
@@ -271,7 +283,7 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 	cmdObj := self.cmd.New(
 		NewGitCmd("show").
 			Config("log.showSignature=false").
-			Arg("--no-patch", "--oneline", "--abbrev=20", prettyFormat).
+			Arg("--no-patch", "--oneline", "--abbrev=20", self.prettyFormat()).
 			Arg(commitShas...).
 			ToArgv(),
 	).DontLog()
@@ -568,56 +580,7 @@ func (self *CommitLoader) getMergeBase(refName string) string {
 }
 
 func (self *CommitLoader) getExistingMainBranches() []string {
-	var existingBranches []string
-	var wg sync.WaitGroup
-
-	mainBranches := self.UserConfig.Git.MainBranches
-	existingBranches = make([]string, len(mainBranches))
-
-	for i, branchName := range mainBranches {
-		wg.Add(1)
-		i := i
-		branchName := branchName
-		go utils.Safe(func() {
-			defer wg.Done()
-
-			// Try to determine upstream of local main branch
-			if ref, err := self.cmd.New(
-				NewGitCmd("rev-parse").Arg("--symbolic-full-name", branchName+"@{u}").ToArgv(),
-			).DontLog().RunWithOutput(); err == nil {
-				existingBranches[i] = strings.TrimSpace(ref)
-				return
-			}
-
-			// If this failed, a local branch for this main branch doesn't exist or it
-			// has no upstream configured. Try looking for one in the "origin" remote.
-			ref := "refs/remotes/origin/" + branchName
-			if err := self.cmd.New(
-				NewGitCmd("rev-parse").Arg("--verify", "--quiet", ref).ToArgv(),
-			).DontLog().Run(); err == nil {
-				existingBranches[i] = ref
-				return
-			}
-
-			// If this failed as well, try if we have the main branch as a local
-			// branch. This covers the case where somebody is using git locally
-			// for something, but never pushing anywhere.
-			ref = "refs/heads/" + branchName
-			if err := self.cmd.New(
-				NewGitCmd("rev-parse").Arg("--verify", "--quiet", ref).ToArgv(),
-			).DontLog().Run(); err == nil {
-				existingBranches[i] = ref
-			}
-		})
-	}
-
-	wg.Wait()
-
-	existingBranches = lo.Filter(existingBranches, func(branch string, _ int) bool {
-		return branch != ""
-	})
-
-	return existingBranches
+	return existingMainBranches(self.cmd, self.UserConfig.Git.MainBranches)
 }
 
 func ignoringWarnings(commandOutput string) string {
@@ -662,7 +625,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		ArgIf(config.Order != "default", "--"+config.Order).
 		ArgIf(opts.All, "--all").
 		Arg("--oneline").
-		Arg(prettyFormat).
+		Arg(self.prettyFormat()).
 		Arg("--abbrev=40").
 		ArgIf(opts.Limit, "-300").
 		ArgIf(opts.FilterPath != "", "--follow").
@@ -672,7 +635,18 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		ArgIf(opts.FilterPath != "", opts.FilterPath).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).DontLog()
+	return self.cmd.New(cmdArgs).CancelWith(opts.Task).DontLog()
 }
 
 const prettyFormat = `--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m`
+
+// %G? makes git verify the signature of every commit, which is slow, so this
+// is opt-in
+const signatureFormat = `%x00%G?%x00%GS`
+
+func (self *CommitLoader) prettyFormat() string {
+	if self.UserConfig.Git.Log.ShowSignatureStatus {
+		return prettyFormat + signatureFormat
+	}
+	return prettyFormat
+}
@@ -35,6 +35,8 @@ func TestGetCommits(t *testing.T) {
 		rebaseMode      enums.RebaseMode
 		opts            GetCommitsOptions
 		mainBranches    []string
+
+		showSignatureStatus bool
 	}
 
 	scenarios := []scenario{
@@ -299,6 +301,35 @@ func TestGetCommits(t *testing.T) {
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
 		},
+		{
+			testName:            "should load signature status if enabled",
+			logOrder:            "default",
+			rebaseMode:          enums.REBASE_MODE_NONE,
+			opts:                GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch"},
+			showSignatureStatus: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%s%x00%m%x00%G?%x00%GS", "--abbrev=40", "--no-show-signature", "--"},
+					singleCommitOutput+"\x00\x00G\x00Jesse Duffield <jessedduffield@gmail.com>", nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Sha:             "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:            "better typing for rebase mode",
+					Status:          models.StatusUnpushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					ExtraInfo:       "(HEAD -> better-tests)",
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640826609,
+					Parents:         []string{"b21997d6b4cbdf84b149"},
+					SignatureStatus: models.SignatureGood,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+				},
+			},
+			expectedError: nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -306,6 +337,7 @@ func TestGetCommits(t *testing.T) {
 		t.Run(scenario.testName, func(t *testing.T) {
 			common := utils.NewDummyCommon()
 			common.UserConfig.Git.Log.Order = scenario.logOrder
+			common.UserConfig.Git.Log.ShowSignatureStatus = scenario.showSignatureStatus
 
 			builder := &CommitLoader{
 				Common:        common,
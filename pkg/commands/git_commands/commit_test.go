@@ -187,6 +187,11 @@ func TestCommitShowCmdObj(t *testing.T) {
 		contextSize      int
 		ignoreWhitespace bool
 		extDiffCmd       string
+		showSignature    bool
+		diffAlgorithm    string
+		colorMoved       bool
+		renameThreshold  int
+		findCopies       bool
 		expected         []string
 	}
 
@@ -231,6 +236,30 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:       "difft --color=always",
 			expected:         []string{"-c", "diff.external=difft --color=always", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890"},
 		},
+		{
+			testName:         "Show signature",
+			filterPath:       "",
+			contextSize:      3,
+			ignoreWhitespace: false,
+			extDiffCmd:       "",
+			showSignature:    true,
+			expected:         []string{"show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--show-signature", "-p", "1234567890"},
+		},
+		{
+			testName:      "Show diff with another diff algorithm",
+			contextSize:   3,
+			diffAlgorithm: "patience",
+			expected:      []string{"show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--diff-algorithm=patience"},
+		},
+		{
+			testName:        "Show diff with moved lines coloured, renames and copies detected",
+			filterPath:      "file.txt",
+			contextSize:     3,
+			colorMoved:      true,
+			renameThreshold: 75,
+			findCopies:      true,
+			expected:        []string{"show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--color-moved", "--find-renames=75%", "--find-copies", "--", "file.txt"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -238,9 +267,14 @@ func TestCommitShowCmdObj(t *testing.T) {
 		t.Run(s.testName, func(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.Paging.ExternalDiffCommand = s.extDiffCmd
+			userConfig.Git.Log.ShowSignatureStatus = s.showSignature
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
+			appState.DiffAlgorithm = s.diffAlgorithm
+			appState.ColorMovedInDiffView = s.colorMoved
+			appState.RenameThreshold = s.renameThreshold
+			appState.FindCopiesInDiffView = s.findCopies
 
 			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expected, "", nil)
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, appState: appState, runner: runner})
@@ -68,6 +68,20 @@ func (self *ConfigCommands) UsingGpg() bool {
 	return self.gitConfig.GetBool("commit.gpgsign")
 }
 
+// GetGpgFormat returns the format used for signing commits and tags, which is
+// one of 'openpgp' (the default), 'x509' or 'ssh'
+func (self *ConfigCommands) GetGpgFormat() string {
+	format := self.gitConfig.Get("gpg.format")
+	if format == "" {
+		return "openpgp"
+	}
+	return format
+}
+
+func (self *ConfigCommands) GetSigningKey() string {
+	return self.gitConfig.Get("user.signingkey")
+}
+
 func (self *ConfigCommands) GetCoreEditor() string {
 	return self.gitConfig.Get("core.editor")
 }
@@ -111,3 +125,8 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
+
+// whether 'git fetch --all' leaves out the given remote
+func (self *ConfigCommands) GetRemoteSkipFetchAll(remoteName string) bool {
+	return self.gitConfig.GetBool("remote." + remoteName + ".skipFetchAll")
+}
@@ -185,3 +185,27 @@ func buildFlowCommands(deps commonDeps) *FlowCommands {
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildLfsCommands(deps commonDeps) *LfsCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewLfsCommands(gitCommon)
+}
+
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRemoteCommands(gitCommon)
+}
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
+func buildSigningCommands(deps commonDeps) *SigningCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewSigningCommands(gitCommon)
+}
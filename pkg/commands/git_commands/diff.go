@@ -1,6 +1,10 @@
 package git_commands
 
-import "github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
 
 type DiffCommands struct {
 	*GitCommon
@@ -14,10 +18,43 @@ func NewDiffCommands(gitCommon *GitCommon) *DiffCommands {
 
 func (self *DiffCommands) DiffCmdObj(diffArgs []string) oscommands.ICmdObj {
 	return self.cmd.New(
-		NewGitCmd("diff").Arg("--submodule", "--no-ext-diff", "--color").Arg(diffArgs...).ToArgv(),
+		NewGitCmd("diff").
+			Arg("--submodule", "--no-ext-diff", "--color").
+			Arg(self.diffOptionArgs(false)...).
+			Arg(self.renameDetectionArgs()...).
+			Arg(diffArgs...).
+			ToArgv(),
 	)
 }
 
+// Returns the arguments for the diff options picked in the diff options menu.
+// Plain diffs are parsed rather than shown, so they don't need the moved lines
+// coloured.
+func (self *GitCommon) diffOptionArgs(plain bool) []string {
+	args := []string{}
+	if self.AppState.DiffAlgorithm != "" {
+		args = append(args, "--diff-algorithm="+self.AppState.DiffAlgorithm)
+	}
+	if !plain && self.AppState.ColorMovedInDiffView {
+		args = append(args, "--color-moved")
+	}
+	return args
+}
+
+// Returns the arguments for detecting renames and copies. Left out of the diffs
+// of single files, which must match how the file lists show them, and of diffs
+// whose output is parsed per path; those pass --no-renames instead.
+func (self *GitCommon) renameDetectionArgs() []string {
+	args := []string{}
+	if self.AppState.RenameThreshold > 0 {
+		args = append(args, fmt.Sprintf("--find-renames=%d%%", self.AppState.RenameThreshold))
+	}
+	if self.AppState.FindCopiesInDiffView {
+		args = append(args, "--find-copies")
+	}
+	return args
+}
+
 func (self *DiffCommands) internalDiffCmdObj(diffArgs ...string) *GitCommandBuilder {
 	return NewGitCmd("diff").
 		Arg("--no-ext-diff", "--no-color").
@@ -5,8 +5,10 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -84,9 +86,49 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	lfsPaths := self.lfsTrackedPaths(files)
+	for _, file := range files {
+		if lfsPaths.Includes(file.Name) {
+			file.LfsStatus, _ = lfsWorktreeStatus(self.Fs, filepath.Join(self.repoPaths.WorktreePath(), file.Name))
+		}
+	}
+
 	return files
 }
 
+// Returns the paths of the files that are managed by git-lfs, which `git lfs
+// track` marks by setting their filter attribute to 'lfs'
+func (self *FileLoader) lfsTrackedPaths(files []*models.File) *set.Set[string] {
+	result := set.New[string]()
+
+	paths := lo.FilterMap(files, func(file *models.File, _ int) (string, bool) {
+		return file.Name, !file.IsWorktree
+	})
+	if len(paths) == 0 {
+		return result
+	}
+
+	cmdArgs := NewGitCmd("check-attr").Arg("-z", "--stdin", "filter").ToArgv()
+	cmdObj := self.cmd.New(cmdArgs).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(paths, "\x00") + "\x00")
+	output, _, err := cmdObj.RunWithOutputs()
+	if err != nil {
+		self.Log.Error(err)
+		return result
+	}
+
+	// the output is made up of the path, the attribute and its value for each
+	// path
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result.Add(fields[i])
+		}
+	}
+
+	return result
+}
+
 // GitStatus returns the file status of the repo
 type GitStatusOptions struct {
 	NoRenames         bool
@@ -1,10 +1,13 @@
 package git_commands
 
 import (
+	"io"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,7 +31,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
 					"MM file1.txt\x00A  file3.txt\x00AM file2.txt\x00?? file4.txt\x00UU file5.txt",
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			[]*models.File{
 				{
 					Name:                    "file1.txt",
@@ -95,7 +99,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 		{
 			"File with new line char",
 			oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"}, "MM a\nb.txt", nil),
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"}, "MM a\nb.txt", nil).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			[]*models.File{
 				{
 					Name:                    "a\nb.txt",
@@ -117,7 +122,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
 					"R  after1.txt\x00before1.txt\x00RM after2.txt\x00before2.txt",
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			[]*models.File{
 				{
 					Name:                    "after1.txt",
@@ -153,7 +159,8 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
 					`?? a -> b.txt`,
 					nil,
-				),
+				).
+				ExpectGitArgs([]string{"check-attr", "-z", "--stdin", "filter"}, "", nil),
 			[]*models.File{
 				{
 					Name:                    "a -> b.txt",
@@ -188,6 +195,40 @@ func TestFileGetStatusFiles(t *testing.T) {
 	}
 }
 
+func TestFileGetStatusFilesLfsStatus(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "/repo/cover.psd", []byte("version https://git-lfs.github.com/spec/v1\noid sha256:abc\nsize 2048\n"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/logo.psd", []byte("actual image content"), 0o644)
+	_ = afero.WriteFile(fs, "/repo/notes.txt", []byte("hello"), 0o644)
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z"},
+			" M cover.psd\x00 M logo.psd\x00 D old.psd\x00?? notes.txt",
+			nil,
+		).
+		ExpectFunc("check-attr", func(cmdObj oscommands.ICmdObj) bool {
+			stdin, _ := io.ReadAll(cmdObj.GetCmd().Stdin)
+			return assert.Equal(t, []string{"git", "check-attr", "-z", "--stdin", "filter"}, cmdObj.Args()) &&
+				assert.Equal(t, "cover.psd\x00logo.psd\x00old.psd\x00notes.txt\x00", string(stdin))
+		},
+			"cover.psd\x00filter\x00lfs\x00logo.psd\x00filter\x00lfs\x00old.psd\x00filter\x00lfs\x00notes.txt\x00filter\x00unspecified\x00",
+			nil,
+		)
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{fs: fs, repoPaths: MockRepoPaths("/repo")}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{})
+	assert.Equal(t,
+		[]models.LfsStatus{models.LfsPointer, models.LfsSmudged, models.LfsAbsent, models.LfsNone},
+		lo.Map(files, func(file *models.File, _ int) models.LfsStatus { return file.LfsStatus }),
+	)
+	runner.CheckForMissingCalls()
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
 }
@@ -0,0 +1,245 @@
+package git_commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Locks returns the locks that the LFS server holds for this repo
+func (self *LfsCommands) Locks() ([]*models.LfsLock, error) {
+	// We can't handle credential prompts while also capturing the output, so
+	// we tell git to fail instead of prompting.
+	cmdArgs := NewGitCmd("lfs").Arg("locks", "--json").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).AddEnvVars("GIT_TERMINAL_PROMPT=0").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLfsLocks(output)
+}
+
+type lfsLockJSON struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+	LockedAt time.Time `json:"locked_at"`
+}
+
+func parseLfsLocks(output string) ([]*models.LfsLock, error) {
+	if strings.TrimSpace(output) == "" {
+		return []*models.LfsLock{}, nil
+	}
+
+	var locks []lfsLockJSON
+	if err := json.Unmarshal([]byte(output), &locks); err != nil {
+		return nil, err
+	}
+
+	return lo.Map(locks, func(lock lfsLockJSON, _ int) *models.LfsLock {
+		return &models.LfsLock{
+			ID:       lock.ID,
+			Path:     lock.Path,
+			Owner:    lock.Owner.Name,
+			LockedAt: lock.LockedAt,
+		}
+	}), nil
+}
+
+func (self *LfsCommands) Lock(task gocui.Task, path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("lock", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Unlock releases the lock with the given ID. Force is needed to release a
+// lock that somebody else holds.
+func (self *LfsCommands) Unlock(task gocui.Task, lockID string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock", "--id="+lockID).
+		ArgIf(force, "--force").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Fetch downloads the LFS objects of the current checkout without updating the
+// working tree
+func (self *LfsCommands) Fetch(task gocui.Task) error {
+	cmdArgs := NewGitCmd("lfs").Arg("fetch").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Pull downloads the LFS objects of the current checkout and replaces the
+// pointers in the working tree with them
+func (self *LfsCommands) Pull(task gocui.Task) error {
+	cmdArgs := NewGitCmd("lfs").Arg("pull").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Prune deletes local copies of LFS objects that are old and have been pushed
+func (self *LfsCommands) Prune() error {
+	cmdArgs := NewGitCmd("lfs").Arg("prune").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// The sizes of the LFS objects before and after a change to a file, or -1
+// where the file doesn't exist
+type LfsObjectChange struct {
+	OldSize int64
+	NewSize int64
+}
+
+// WorktreeObjectChange returns how the LFS object of the given file changed in
+// the working tree or, if cached is true, in the index. Returns false if
+// neither side of the change is an LFS pointer, which happens when git-lfs
+// isn't set up for the repo.
+func (self *LfsCommands) WorktreeObjectChange(file *models.File, cached bool) (LfsObjectChange, bool) {
+	oldPath := file.Name
+	if file.PreviousName != "" {
+		oldPath = file.PreviousName
+	}
+
+	if cached {
+		return self.objectChange("HEAD:"+oldPath, ":"+file.Name)
+	}
+
+	oldSize, oldIsPointer := self.blobObjectSize(":" + oldPath)
+	newSize, newIsPointer := self.worktreeObjectSize(file.Name)
+	return LfsObjectChange{OldSize: oldSize, NewSize: newSize}, oldIsPointer || newIsPointer
+}
+
+// ObjectChange returns how the LFS object of the given file changed between
+// two refs. Returns false if neither side of the change is an LFS pointer.
+func (self *LfsCommands) ObjectChange(from string, to string, reverse bool, path string) (LfsObjectChange, bool) {
+	if reverse {
+		from, to = to, from
+	}
+
+	return self.objectChange(from+":"+path, to+":"+path)
+}
+
+func (self *LfsCommands) objectChange(oldRev string, newRev string) (LfsObjectChange, bool) {
+	oldSize, oldIsPointer := self.blobObjectSize(oldRev)
+	newSize, newIsPointer := self.blobObjectSize(newRev)
+	return LfsObjectChange{OldSize: oldSize, NewSize: newSize}, oldIsPointer || newIsPointer
+}
+
+// Returns the size of the LFS object that the given blob points to, or the
+// size of the blob itself if it isn't a pointer. The size is -1 if there's no
+// such blob. Only blobs that are small enough to be pointers are read.
+func (self *LfsCommands) blobObjectSize(rev string) (int64, bool) {
+	sizeArgs := NewGitCmd("cat-file").Arg("-s", rev).
+		ToArgv()
+
+	sizeOutput, _, err := self.cmd.New(sizeArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		return -1, false
+	}
+	blobSize, err := strconv.ParseInt(strings.TrimSpace(sizeOutput), 10, 64)
+	if err != nil {
+		return -1, false
+	}
+	if blobSize > maxLfsPointerSize {
+		return blobSize, false
+	}
+
+	contentArgs := NewGitCmd("cat-file").Arg("blob", rev).
+		ToArgv()
+
+	content, _, err := self.cmd.New(contentArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		return -1, false
+	}
+
+	if size, ok := parseLfsPointer(content); ok {
+		return size, true
+	}
+	return blobSize, false
+}
+
+// Like blobObjectSize, for the copy of the file in the working tree
+func (self *LfsCommands) worktreeObjectSize(path string) (int64, bool) {
+	status, size := lfsWorktreeStatus(self.Fs, filepath.Join(self.repoPaths.WorktreePath(), path))
+	switch status {
+	case models.LfsAbsent:
+		return -1, false
+	case models.LfsPointer:
+		return size, true
+	default:
+		return size, false
+	}
+}
+
+// Pointer files are small, so anything bigger must be the actual content.
+// git-lfs uses the same limit.
+const maxLfsPointerSize = 1024
+
+var lfsPointerSizeRegexp = regexp.MustCompile(`(?m)^size (\d+)$`)
+
+// Returns the size of the LFS object that the given content points to, or
+// false if it isn't an LFS pointer
+func parseLfsPointer(content string) (int64, bool) {
+	if len(content) > maxLfsPointerSize || !strings.HasPrefix(content, "version https://git-lfs.github.com/spec/v1\n") {
+		return 0, false
+	}
+
+	match := lfsPointerSizeRegexp.FindStringSubmatch(content)
+	if match == nil {
+		return 0, false
+	}
+
+	size, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return size, true
+}
+
+// Returns what the working tree holds for a file that's managed by git-lfs,
+// and the size of its LFS object
+func lfsWorktreeStatus(fs afero.Fs, path string) (models.LfsStatus, int64) {
+	info, err := fs.Stat(path)
+	if err != nil || info.IsDir() {
+		return models.LfsAbsent, -1
+	}
+
+	if info.Size() <= maxLfsPointerSize {
+		content, err := afero.ReadFile(fs, path)
+		if err == nil {
+			if size, ok := parseLfsPointer(string(content)); ok {
+				return models.LfsPointer, size
+			}
+		}
+	}
+
+	return models.LfsSmudged, info.Size()
+}
@@ -0,0 +1,176 @@
+package git_commands
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func lfsPointer(size string) string {
+	return "version https://git-lfs.github.com/spec/v1\n" +
+		"oid sha256:4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393\n" +
+		"size " + size + "\n"
+}
+
+func TestLfsParsePointer(t *testing.T) {
+	size, ok := parseLfsPointer(lfsPointer("12345"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(12345), size)
+
+	_, ok = parseLfsPointer("size 12345\n")
+	assert.False(t, ok)
+
+	_, ok = parseLfsPointer("version https://git-lfs.github.com/spec/v1\noid sha256:abc\n")
+	assert.False(t, ok)
+}
+
+func TestLfsLocks(t *testing.T) {
+	output := `[{"id":"3","path":"art/cover.psd","owner":{"name":"Jane"},"locked_at":"2024-03-01T10:20:30Z"},` +
+		`{"id":"7","path":"sounds/a.wav","owner":{"name":"Joe"},"locked_at":"2024-03-02T08:00:00Z"}]`
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"lfs", "locks", "--json"}, output, nil)
+	instance := buildLfsCommands(commonDeps{runner: runner})
+
+	locks, err := instance.Locks()
+	assert.NoError(t, err)
+	assert.EqualValues(t, []*models.LfsLock{
+		{ID: "3", Path: "art/cover.psd", Owner: "Jane", LockedAt: time.Date(2024, 3, 1, 10, 20, 30, 0, time.UTC)},
+		{ID: "7", Path: "sounds/a.wav", Owner: "Joe", LockedAt: time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC)},
+	}, locks)
+	runner.CheckForMissingCalls()
+}
+
+func TestLfsUnlock(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		force        bool
+		expectedArgs []string
+	}{
+		{
+			testName:     "own lock",
+			force:        false,
+			expectedArgs: []string{"lfs", "unlock", "--id=3"},
+		},
+		{
+			testName:     "somebody else's lock",
+			force:        true,
+			expectedArgs: []string{"lfs", "unlock", "--id=3", "--force"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildLfsCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Unlock(nil, "3", s.force))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+// The output of git cat-file -s for the given content
+func blobSize(content string) string {
+	return fmt.Sprintf("%d\n", len(content))
+}
+
+func TestLfsWorktreeObjectChange(t *testing.T) {
+	type scenario struct {
+		testName       string
+		file           *models.File
+		cached         bool
+		worktreeFile   string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedChange LfsObjectChange
+		expectedOk     bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "pointer changed in the working tree",
+			file:         &models.File{Name: "cover.psd"},
+			worktreeFile: lfsPointer("2048"),
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":cover.psd"}, blobSize(lfsPointer("1024")), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":cover.psd"}, lfsPointer("1024"), nil),
+			expectedChange: LfsObjectChange{OldSize: 1024, NewSize: 2048},
+			expectedOk:     true,
+		},
+		{
+			testName:     "content in the working tree",
+			file:         &models.File{Name: "cover.psd"},
+			worktreeFile: "hello",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":cover.psd"}, blobSize(lfsPointer("1024")), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":cover.psd"}, lfsPointer("1024"), nil),
+			expectedChange: LfsObjectChange{OldSize: 1024, NewSize: 5},
+			expectedOk:     true,
+		},
+		{
+			testName: "staged rename",
+			file:     &models.File{Name: "new.psd", PreviousName: "old.psd"},
+			cached:   true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:old.psd"}, blobSize(lfsPointer("1024")), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", "HEAD:old.psd"}, lfsPointer("1024"), nil).
+				ExpectGitArgs([]string{"cat-file", "-s", ":new.psd"}, blobSize(lfsPointer("4096")), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":new.psd"}, lfsPointer("4096"), nil),
+			expectedChange: LfsObjectChange{OldSize: 1024, NewSize: 4096},
+			expectedOk:     true,
+		},
+		{
+			testName: "staged new file",
+			file:     &models.File{Name: "cover.psd"},
+			cached:   true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", "HEAD:cover.psd"}, "", errors.New("fatal: path 'cover.psd' does not exist in 'HEAD'")).
+				ExpectGitArgs([]string{"cat-file", "-s", ":cover.psd"}, blobSize(lfsPointer("4096")), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":cover.psd"}, lfsPointer("4096"), nil),
+			expectedChange: LfsObjectChange{OldSize: -1, NewSize: 4096},
+			expectedOk:     true,
+		},
+		{
+			testName:     "no pointers",
+			file:         &models.File{Name: "cover.psd"},
+			worktreeFile: "hello",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":cover.psd"}, blobSize("hi"), nil).
+				ExpectGitArgs([]string{"cat-file", "blob", ":cover.psd"}, "hi", nil),
+			expectedChange: LfsObjectChange{OldSize: 2, NewSize: 5},
+			expectedOk:     false,
+		},
+		{
+			testName:     "blobs too big to be pointers aren't read",
+			file:         &models.File{Name: "cover.psd"},
+			worktreeFile: "hello",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"cat-file", "-s", ":cover.psd"}, "5000000\n", nil),
+			expectedChange: LfsObjectChange{OldSize: 5000000, NewSize: 5},
+			expectedOk:     false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.worktreeFile != "" {
+				_ = afero.WriteFile(fs, "/repo/"+s.file.Name, []byte(s.worktreeFile), 0o644)
+			}
+			instance := buildLfsCommands(commonDeps{runner: s.runner, fs: fs, repoPaths: MockRepoPaths("/repo")})
+
+			change, ok := instance.WorktreeObjectChange(s.file, s.cached)
+			assert.Equal(t, s.expectedChange, change)
+			assert.Equal(t, s.expectedOk, ok)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
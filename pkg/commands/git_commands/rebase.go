@@ -7,6 +7,7 @@ import (
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/app/daemon"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -343,16 +344,21 @@ func (self *RebaseCommands) BeginInteractiveRebaseForCommit(
 	}).Run()
 }
 
-// RebaseBranch interactive rebases onto a branch
-func (self *RebaseCommands) RebaseBranch(branchName string) error {
-	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{baseShaOrRoot: branchName}).Run()
+// RebaseBranch interactive rebases onto a branch. The rebase is terminated when
+// the task is cancelled, e.g. because an exec line of the todo list hangs
+func (self *RebaseCommands) RebaseBranch(task gocui.Task, branchName string) error {
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{baseShaOrRoot: branchName}).
+		CancelWith(task).
+		Run()
 }
 
-func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string, baseCommit string) error {
+func (self *RebaseCommands) RebaseBranchFromBaseCommit(task gocui.Task, targetBranchName string, baseCommit string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseShaOrRoot: baseCommit,
 		onto:          targetBranchName,
-	}).Run()
+	}).
+		CancelWith(task).
+		Run()
 }
 
 func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string, command string) oscommands.ICmdObj {
@@ -362,17 +368,18 @@ func (self *RebaseCommands) GenericMergeOrRebaseActionCmdObj(commandType string,
 }
 
 func (self *RebaseCommands) ContinueRebase() error {
-	return self.GenericMergeOrRebaseAction("rebase", "continue")
+	return self.GenericMergeOrRebaseAction(nil, "rebase", "continue")
 }
 
 func (self *RebaseCommands) AbortRebase() error {
-	return self.GenericMergeOrRebaseAction("rebase", "abort")
+	return self.GenericMergeOrRebaseAction(nil, "rebase", "abort")
 }
 
 // GenericMerge takes a commandType of "merge" or "rebase" and a command of "abort", "skip" or "continue"
-// By default we skip the editor in the case where a commit will be made
-func (self *RebaseCommands) GenericMergeOrRebaseAction(commandType string, command string) error {
-	err := self.runSkipEditorCommand(self.GenericMergeOrRebaseActionCmdObj(commandType, command))
+// By default we skip the editor in the case where a commit will be made. The
+// command is terminated when the task is cancelled; pass nil if there's no task.
+func (self *RebaseCommands) GenericMergeOrRebaseAction(task gocui.Task, commandType string, command string) error {
+	err := self.runSkipEditorCommand(self.GenericMergeOrRebaseActionCmdObj(commandType, command).CancelWith(task))
 	if err != nil {
 		if !strings.Contains(err.Error(), "no rebase in progress") {
 			return err
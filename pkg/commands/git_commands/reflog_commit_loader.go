@@ -4,9 +4,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/samber/lo"
 )
 
 type ReflogCommitLoader struct {
@@ -63,6 +65,94 @@ func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit
 	return commits, onlyObtainedNewReflogCommits, nil
 }
 
+// GetBranchReflogCommits returns the reflog of the given local branch, which
+// (unlike the HEAD reflog) records every commit the branch has pointed to.
+func (self *ReflogCommitLoader) GetBranchReflogCommits(branchName string) ([]*models.Commit, error) {
+	cmdArgs := NewGitCmd("reflog").
+		Config("log.showSignature=false").
+		Arg("show").
+		Arg("--abbrev=40").
+		Arg("--format=%h%x00%ct%x00%gs%x00%p").
+		Arg("refs/heads/" + branchName).
+		Arg("--").
+		ToArgv()
+
+	return self.loadCommits(self.cmd.New(cmdArgs).DontLog(), models.StatusReflog)
+}
+
+// GetDanglingCommits returns the commits that git fsck reports as unreachable,
+// i.e. commits that are no longer reachable from any ref or reflog. This
+// includes the commits of stashes that have been dropped, but not those of the
+// stashes that only the reflog of refs/stash keeps. Unlike --lost-found,
+// --unreachable doesn't write anything to the .git directory.
+func (self *ReflogCommitLoader) GetDanglingCommits() ([]*models.Commit, error) {
+	cmdArgs := NewGitCmd("fsck").Arg("--unreachable").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	shas := parseDanglingCommitShas(output)
+	if len(shas) == 0 {
+		return []*models.Commit{}, nil
+	}
+
+	// there can be lots of them, so pass them via stdin rather than as
+	// arguments to avoid exceeding the maximum command line length
+	logArgs := NewGitCmd("log").
+		Config("log.showSignature=false").
+		Arg("--no-walk", "--stdin").
+		Arg("--abbrev=40").
+		Arg("--format=%H%x00%ct%x00%s%x00%p").
+		Arg("--").
+		ToArgv()
+
+	cmdObj := self.cmd.New(logArgs).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(shas, "\n") + "\n")
+	commits, err := self.loadCommits(cmdObj, models.StatusNone)
+	if err != nil {
+		return nil, err
+	}
+
+	// fsck also reports the ancestors of unreachable commits (e.g. the index
+	// commit of a dropped stash); only the tips are worth recovering
+	parents := set.NewFromSlice(lo.FlatMap(commits, func(commit *models.Commit, _ int) []string {
+		return commit.Parents
+	}))
+	return lo.Filter(commits, func(commit *models.Commit, _ int) bool {
+		return !parents.Includes(commit.Sha)
+	}), nil
+}
+
+func parseDanglingCommitShas(output string) []string {
+	shas := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		sha, ok := strings.CutPrefix(strings.TrimSpace(line), "unreachable commit ")
+		if ok {
+			shas = append(shas, sha)
+		}
+	}
+	return shas
+}
+
+func (self *ReflogCommitLoader) loadCommits(cmdObj oscommands.ICmdObj, status models.CommitStatus) ([]*models.Commit, error) {
+	commits := []*models.Commit{}
+	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		commit, ok := self.parseLine(line)
+		if ok {
+			commit.Status = status
+			commits = append(commits, commit)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
 func (self *ReflogCommitLoader) sameReflogCommit(a *models.Commit, b *models.Commit) bool {
 	return a.Sha == b.Sha && a.UnixTimestamp == b.UnixTimestamp && a.Name == b.Name
 }
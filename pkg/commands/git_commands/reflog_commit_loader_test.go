@@ -167,3 +167,97 @@ func TestGetReflogCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBranchReflogCommits(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-c", "log.showSignature=false", "reflog", "show", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p", "refs/heads/feature", "--"},
+			strings.Replace("a1b2c3|1643150483|reset: moving to HEAD~1|d4e5f6\nd4e5f6|1643149435|commit: add feature|\n", "|", "\x00", -1), nil)
+
+	loader := &ReflogCommitLoader{
+		Common: utils.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+	}
+
+	commits, err := loader.GetBranchReflogCommits("feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Commit{
+		{
+			Sha:           "a1b2c3",
+			Name:          "reset: moving to HEAD~1",
+			Status:        models.StatusReflog,
+			UnixTimestamp: 1643150483,
+			Parents:       []string{"d4e5f6"},
+		},
+		{
+			Sha:           "d4e5f6",
+			Name:          "commit: add feature",
+			Status:        models.StatusReflog,
+			UnixTimestamp: 1643149435,
+			Parents:       []string{},
+		},
+	}, commits)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestGetDanglingCommits(t *testing.T) {
+	scenarios := []struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedCommits []*models.Commit
+		expectedError   error
+	}{
+		{
+			testName: "no dangling commits",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"fsck", "--unreachable"}, "unreachable blob 0123abcd\n", nil),
+			expectedCommits: []*models.Commit{},
+		},
+		{
+			testName: "dangling commits including a dropped stash and its index commit",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"fsck", "--unreachable"}, "Checking object directories: 100% (256/256), done.\nunreachable commit aaaa\nunreachable blob bbbb\nunreachable commit cccc\nunreachable commit 5678\n", nil).
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "--no-walk", "--stdin", "--abbrev=40", "--format=%H%x00%ct%x00%s%x00%p", "--"},
+					strings.Replace("aaaa|1643150483|WIP on master: 1234 commit|1234 5678\ncccc|1643149435|lost commit|1234\n5678|1643150483|index on master: 1234 commit|1234\n", "|", "\x00", -1), nil),
+			expectedCommits: []*models.Commit{
+				{
+					Sha:           "aaaa",
+					Name:          "WIP on master: 1234 commit",
+					Status:        models.StatusNone,
+					UnixTimestamp: 1643150483,
+					Parents:       []string{"1234", "5678"},
+				},
+				{
+					Sha:           "cccc",
+					Name:          "lost commit",
+					Status:        models.StatusNone,
+					UnixTimestamp: 1643149435,
+					Parents:       []string{"1234"},
+				},
+			},
+		},
+		{
+			testName: "when fsck fails",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"fsck", "--unreachable"}, "", errors.New("error")),
+			expectedCommits: nil,
+			expectedError:   errors.New("error"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+		t.Run(scenario.testName, func(t *testing.T) {
+			loader := &ReflogCommitLoader{
+				Common: utils.NewDummyCommon(),
+				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
+			}
+
+			commits, err := loader.GetDanglingCommits()
+			assert.Equal(t, scenario.expectedError, err)
+			assert.Equal(t, scenario.expectedCommits, commits)
+
+			scenario.runner.CheckForMissingCalls()
+		})
+	}
+}
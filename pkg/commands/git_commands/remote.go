@@ -2,8 +2,12 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemoteCommands struct {
@@ -64,6 +68,16 @@ func (self *RemoteCommands) DeleteRemoteTag(task gocui.Task, remoteName string,
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
+// DeleteRemoteTags deletes several tags from the remote at once
+func (self *RemoteCommands) DeleteRemoteTags(task gocui.Task, remoteName string, tagNames []string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg(remoteName, "--delete").
+		Arg(tagRefs(tagNames)...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 // CheckRemoteBranchExists Returns remote branch
 func (self *RemoteCommands) CheckRemoteBranchExists(branchName string) bool {
 	cmdArgs := NewGitCmd("show-ref").
@@ -74,3 +88,183 @@ func (self *RemoteCommands) CheckRemoteBranchExists(branchName string) bool {
 
 	return err == nil
 }
+
+// PruneDryRun returns the remote-tracking branches (e.g. 'origin/feature') that
+// would be deleted by pruning the given remote, because the branch no longer
+// exists on the remote.
+func (self *RemoteCommands) PruneDryRun(remoteName string) ([]string, error) {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune", "--dry-run", remoteName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).
+		// we can't prompt for credentials while capturing output, so we'd rather fail
+		AddEnvVars("GIT_TERMINAL_PROMPT=0").
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePruneOutput(output), nil
+}
+
+// Output looks like:
+//
+//	Pruning origin
+//	URL: git@github.com:jesseduffield/lazygit.git
+//	 * [would prune] origin/feature
+func parsePruneOutput(output string) []string {
+	branches := []string{}
+	for _, line := range utils.SplitLines(output) {
+		_, branch, found := strings.Cut(line, "[would prune] ")
+		if !found {
+			continue
+		}
+		branches = append(branches, strings.TrimSpace(branch))
+	}
+	return branches
+}
+
+// FetchAndPrune fetches the given remote and deletes any of its remote-tracking
+// branches that no longer exist on the remote
+func (self *RemoteCommands) FetchAndPrune(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg("--prune", remoteName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// MergedRemoteBranches returns the names (without the remote prefix) of the
+// given remote's branches which are fully merged into any of the configured
+// main branches on that remote. The main branches themselves are excluded.
+func (self *RemoteCommands) MergedRemoteBranches(remoteName string) ([]string, error) {
+	mainBranches := self.UserConfig.Git.MainBranches
+	prefix := "refs/remotes/" + remoteName + "/"
+
+	result := []string{}
+	for _, mainBranch := range mainBranches {
+		ref := prefix + mainBranch
+		if err := self.cmd.New(
+			NewGitCmd("rev-parse").Arg("--verify", "--quiet", ref).ToArgv(),
+		).DontLog().Run(); err != nil {
+			// main branch doesn't exist on this remote
+			continue
+		}
+
+		output, err := self.cmd.New(
+			NewGitCmd("for-each-ref").
+				Arg("--merged", ref, "--format=%(refname)", prefix).
+				ToArgv(),
+		).DontLog().RunWithOutput()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range utils.SplitLines(output) {
+			name := strings.TrimPrefix(strings.TrimSpace(line), prefix)
+			if name == "HEAD" || lo.Contains(mainBranches, name) || lo.Contains(result, name) {
+				continue
+			}
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteRemoteBranches deletes several branches from a remote with a single push
+func (self *RemoteCommands) DeleteRemoteBranches(task gocui.Task, remoteName string, branchNames []string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg(remoteName, "--delete").
+		Arg(branchNames...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// GetPushUrls returns the push URLs explicitly configured for the remote (i.e.
+// via remote.<name>.pushurl). If none are configured, git pushes to the fetch URL.
+func (self *RemoteCommands) GetPushUrls(remoteName string) ([]string, error) {
+	return self.getAllConfigValues(fmt.Sprintf("remote.%s.pushurl", remoteName))
+}
+
+func (self *RemoteCommands) AddPushUrl(remoteName string, url string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--add", "--push", remoteName, url).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) UpdatePushUrl(remoteName string, oldUrl string, newUrl string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--push", remoteName, newUrl, exactMatchRegex(oldUrl)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) RemovePushUrl(remoteName string, url string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("set-url", "--delete", "--push", remoteName, exactMatchRegex(url)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// GetFetchRefspecs returns the refspecs used when fetching from the remote
+// e.g. '+refs/heads/*:refs/remotes/origin/*'
+func (self *RemoteCommands) GetFetchRefspecs(remoteName string) ([]string, error) {
+	return self.getAllConfigValues(fmt.Sprintf("remote.%s.fetch", remoteName))
+}
+
+func (self *RemoteCommands) AddFetchRefspec(remoteName string, refspec string) error {
+	cmdArgs := NewGitCmd("config").
+		Arg("--add", fmt.Sprintf("remote.%s.fetch", remoteName), refspec).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) UpdateFetchRefspec(remoteName string, oldRefspec string, newRefspec string) error {
+	cmdArgs := NewGitCmd("config").
+		Arg("--replace-all", fmt.Sprintf("remote.%s.fetch", remoteName), newRefspec, exactMatchRegex(oldRefspec)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) RemoveFetchRefspec(remoteName string, refspec string) error {
+	cmdArgs := NewGitCmd("config").
+		Arg("--unset", fmt.Sprintf("remote.%s.fetch", remoteName), exactMatchRegex(refspec)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *RemoteCommands) getAllConfigValues(key string) ([]string, error) {
+	cmdArgs := NewGitCmd("config").
+		Arg("--get-all", key).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		// git config exits with 1 when the key isn't set
+		if strings.TrimSpace(output) == "" {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	return lo.Filter(utils.SplitLines(output), func(line string, _ int) bool {
+		return strings.TrimSpace(line) != ""
+	}), nil
+}
+
+// git config and git remote set-url take a regex to select which value to
+// change; we want to select exactly the given value
+func exactMatchRegex(value string) string {
+	return "^" + regexp.QuoteMeta(value) + "$"
+}
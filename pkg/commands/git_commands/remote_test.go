@@ -0,0 +1,155 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemotePruneDryRun(t *testing.T) {
+	type scenario struct {
+		testName         string
+		runner           *oscommands.FakeCmdObjRunner
+		expectedBranches []string
+		expectedErr      error
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "nothing to prune",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"remote", "prune", "--dry-run", "origin"}, "", nil),
+			expectedBranches: []string{},
+			expectedErr:      nil,
+		},
+		{
+			testName: "stale branches",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"remote", "prune", "--dry-run", "origin"},
+					"Pruning origin\nURL: git@github.com:jesseduffield/lazygit.git\n * [would prune] origin/feature\n * [would prune] origin/fix/typo\n", nil),
+			expectedBranches: []string{"origin/feature", "origin/fix/typo"},
+			expectedErr:      nil,
+		},
+		{
+			testName: "error",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"remote", "prune", "--dry-run", "origin"}, "", errors.New("error")),
+			expectedBranches: nil,
+			expectedErr:      errors.New("error"),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRemoteCommands(commonDeps{runner: s.runner})
+			branches, err := instance.PruneDryRun("origin")
+			assert.EqualValues(t, s.expectedBranches, branches)
+			if s.expectedErr != nil {
+				assert.EqualError(t, err, s.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRemoteMergedRemoteBranches(t *testing.T) {
+	type scenario struct {
+		testName         string
+		mainBranches     []string
+		runner           *oscommands.FakeCmdObjRunner
+		expectedBranches []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "no main branch on remote",
+			mainBranches: []string{"master", "main"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/master"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/main"}, "", errors.New("error")),
+			expectedBranches: []string{},
+		},
+		{
+			testName:     "merged into several main branches",
+			mainBranches: []string{"master", "develop"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/master"}, "", nil).
+				ExpectGitArgs([]string{"for-each-ref", "--merged", "refs/remotes/origin/master", "--format=%(refname)", "refs/remotes/origin/"},
+					"refs/remotes/origin/HEAD\nrefs/remotes/origin/feature/one\nrefs/remotes/origin/master\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/develop"}, "", nil).
+				ExpectGitArgs([]string{"for-each-ref", "--merged", "refs/remotes/origin/develop", "--format=%(refname)", "refs/remotes/origin/"},
+					"refs/remotes/origin/develop\nrefs/remotes/origin/feature/one\nrefs/remotes/origin/feature/two\nrefs/remotes/origin/master\n", nil),
+			expectedBranches: []string{"feature/one", "feature/two"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.MainBranches = s.mainBranches
+			instance := buildRemoteCommands(commonDeps{runner: s.runner, userConfig: userConfig})
+			branches, err := instance.MergedRemoteBranches("origin")
+			assert.NoError(t, err)
+			assert.EqualValues(t, s.expectedBranches, branches)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRemoteDeleteRemoteBranches(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "--delete", "one", "two"}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.DeleteRemoteBranches(nil, "origin", []string{"one", "two"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemotePushUrls(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--get-all", "remote.origin.pushurl"}, "", errors.New("exit status 1")).
+		ExpectGitArgs([]string{"remote", "set-url", "--add", "--push", "origin", "git@a.com:x.git"}, "", nil).
+		ExpectGitArgs([]string{"config", "--get-all", "remote.origin.pushurl"}, "git@a.com:x.git\ngit@b.com:x.git\n", nil).
+		ExpectGitArgs([]string{"remote", "set-url", "--push", "origin", "git@c.com:x.git", `^git@a\.com:x\.git$`}, "", nil).
+		ExpectGitArgs([]string{"remote", "set-url", "--delete", "--push", "origin", `^git@b\.com:x\.git$`}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	urls, err := instance.GetPushUrls("origin")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{}, urls)
+
+	assert.NoError(t, instance.AddPushUrl("origin", "git@a.com:x.git"))
+
+	urls, err = instance.GetPushUrls("origin")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"git@a.com:x.git", "git@b.com:x.git"}, urls)
+
+	assert.NoError(t, instance.UpdatePushUrl("origin", "git@a.com:x.git", "git@c.com:x.git"))
+	assert.NoError(t, instance.RemovePushUrl("origin", "git@b.com:x.git"))
+	runner.CheckForMissingCalls()
+}
+
+func TestRemoteFetchRefspecs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--get-all", "remote.origin.fetch"}, "+refs/heads/*:refs/remotes/origin/*\n", nil).
+		ExpectGitArgs([]string{"config", "--add", "remote.origin.fetch", "+refs/pull/*/head:refs/remotes/origin/pr/*"}, "", nil).
+		ExpectGitArgs([]string{"config", "--replace-all", "remote.origin.fetch", "+refs/heads/main:refs/remotes/origin/main", `^\+refs/heads/\*:refs/remotes/origin/\*$`}, "", nil).
+		ExpectGitArgs([]string{"config", "--unset", "remote.origin.fetch", `^\+refs/pull/\*/head:refs/remotes/origin/pr/\*$`}, "", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	refspecs, err := instance.GetFetchRefspecs("origin")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []string{"+refs/heads/*:refs/remotes/origin/*"}, refspecs)
+
+	assert.NoError(t, instance.AddFetchRefspec("origin", "+refs/pull/*/head:refs/remotes/origin/pr/*"))
+	assert.NoError(t, instance.UpdateFetchRefspec("origin", "+refs/heads/*:refs/remotes/origin/*", "+refs/heads/main:refs/remotes/origin/main"))
+	assert.NoError(t, instance.RemoveFetchRefspec("origin", "+refs/pull/*/head:refs/remotes/origin/pr/*"))
+	runner.CheckForMissingCalls()
+}
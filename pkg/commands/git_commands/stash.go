@@ -87,6 +87,8 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) oscommands.ICmdObj {
 		Arg(fmt.Sprintf("--color=%s", self.UserConfig.Git.Paging.ColorArg)).
 		Arg(fmt.Sprintf("--unified=%d", self.AppState.DiffContextSize)).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(self.diffOptionArgs(false)...).
+		Arg(self.renameDetectionArgs()...).
 		Arg(fmt.Sprintf("stash@{%d}", index)).
 		ToArgv()
 
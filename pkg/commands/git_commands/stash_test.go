@@ -103,6 +103,10 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 		index            int
 		contextSize      int
 		ignoreWhitespace bool
+		diffAlgorithm    string
+		colorMoved       bool
+		renameThreshold  int
+		findCopies       bool
 		expected         []string
 	}
 
@@ -128,6 +132,16 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			ignoreWhitespace: true,
 			expected:         []string{"git", "stash", "show", "-p", "--stat", "--color=always", "--unified=3", "--ignore-all-space", "stash@{5}"},
 		},
+		{
+			testName:        "Show diff with diff options",
+			index:           5,
+			contextSize:     3,
+			diffAlgorithm:   "histogram",
+			colorMoved:      true,
+			renameThreshold: 30,
+			findCopies:      true,
+			expected:        []string{"git", "stash", "show", "-p", "--stat", "--color=always", "--unified=3", "--diff-algorithm=histogram", "--color-moved", "--find-renames=30%", "--find-copies", "stash@{5}"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -137,6 +151,10 @@ func TestStashStashEntryCmdObj(t *testing.T) {
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
+			appState.DiffAlgorithm = s.diffAlgorithm
+			appState.ColorMovedInDiffView = s.colorMoved
+			appState.RenameThreshold = s.renameThreshold
+			appState.FindCopiesInDiffView = s.findCopies
 			instance := buildStashCommands(commonDeps{userConfig: userConfig, appState: appState})
 
 			cmdStr := instance.ShowStashEntryCmdObj(s.index).Args()
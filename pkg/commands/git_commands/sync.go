@@ -1,9 +1,19 @@
 package git_commands
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SyncCommands struct {
@@ -18,10 +28,21 @@ func NewSyncCommands(gitCommon *GitCommon) *SyncCommands {
 
 // Push pushes to a branch
 type PushOpts struct {
-	Force          bool
-	UpstreamRemote string
-	UpstreamBranch string
-	SetUpstream    bool
+	Force bool
+	// Only used together with Force; ignored for git versions older than 2.30
+	ForceIfIncludes bool
+	UpstreamRemote  string
+	UpstreamBranch  string
+	SetUpstream     bool
+	// Pushed instead of the current branch, e.g. 'feature:review' or
+	// 'refs/tags/v1.0'. Requires UpstreamRemote.
+	Refspecs []string
+	// Either all refs are updated on the remote or none are
+	Atomic bool
+	// Passed to the server with --push-option, e.g. 'ci.skip'
+	PushOptions []string
+	// Only shows what would be pushed, see PushDryRun
+	DryRun bool
 }
 
 func (self *SyncCommands) PushCmdObj(task gocui.Task, opts PushOpts) (oscommands.ICmdObj, error) {
@@ -29,11 +50,23 @@ func (self *SyncCommands) PushCmdObj(task gocui.Task, opts PushOpts) (oscommands
 		return nil, errors.New(self.Tr.MustSpecifyOriginError)
 	}
 
+	if len(opts.Refspecs) > 0 && opts.UpstreamRemote == "" {
+		return nil, errors.New(self.Tr.MustSpecifyRemoteForRefspecsError)
+	}
+
 	cmdArgs := NewGitCmd("push").
+		ArgIf(oscommands.WantsProgress(task), "--progress").
 		ArgIf(opts.Force, "--force-with-lease").
+		ArgIf(opts.Force && opts.ForceIfIncludes && !self.version.IsOlderThan(2, 30, 0), "--force-if-includes").
 		ArgIf(opts.SetUpstream, "--set-upstream").
+		ArgIf(opts.Atomic, "--atomic").
+		Arg(lo.Map(opts.PushOptions, func(pushOption string, _ int) string {
+			return "--push-option=" + pushOption
+		})...).
+		ArgIf(opts.DryRun, "--dry-run", "--porcelain").
 		ArgIf(opts.UpstreamRemote != "", opts.UpstreamRemote).
 		ArgIf(opts.UpstreamBranch != "", opts.UpstreamBranch).
+		Arg(opts.Refspecs...).
 		ToArgv()
 
 	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
@@ -49,6 +82,68 @@ func (self *SyncCommands) Push(task gocui.Task, opts PushOpts) error {
 	return cmdObj.Run()
 }
 
+// A ref that a push or fetch updates
+type RefUpdate struct {
+	// One of the flags that git push and git fetch print for a ref: ' ' for a
+	// fast-forward, '+' for a forced update, '-' for a deleted ref, '*' for a
+	// new ref, 't' for an updated tag, '!' for a rejected update and '=' for a
+	// ref that is up to date
+	Flag byte
+	// The ref that is copied, empty for a deleted ref
+	From string
+	// The ref that is updated, e.g. the remote ref when pushing or the remote
+	// branch when fetching
+	To string
+	// E.g. 'abc123..def456' or '[rejected] (non-fast-forward)'
+	Summary string
+}
+
+// Returns the ref updates that pushing with the given options would make,
+// without making them. Rejected updates are returned rather than reported as
+// an error.
+func (self *SyncCommands) PushDryRun(task gocui.Task, opts PushOpts) ([]*RefUpdate, error) {
+	opts.DryRun = true
+	cmdObj, err := self.PushCmdObj(task, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := cmdObj.RunWithOutput()
+	updates := parsePushPorcelain(output)
+	// git exits with an error if any update would be rejected
+	if err != nil && len(updates) == 0 {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// Parses the output of 'git push --porcelain', which has a line per ref like
+// '<flag>\t<from>:<to>\t<summary>', surrounded by 'To <url>' and 'Done' lines
+func parsePushPorcelain(output string) []*RefUpdate {
+	updates := []*RefUpdate{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 || len(fields[0]) != 1 {
+			continue
+		}
+
+		from, to, found := strings.Cut(fields[1], ":")
+		if !found {
+			continue
+		}
+
+		updates = append(updates, &RefUpdate{
+			Flag:    fields[0][0],
+			From:    from,
+			To:      to,
+			Summary: strings.TrimSpace(fields[2]),
+		})
+	}
+
+	return updates
+}
+
 func (self *SyncCommands) FetchCmdObj(task gocui.Task) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("fetch").
 		ArgIf(self.UserConfig.Git.FetchAll, "--all").
@@ -69,7 +164,8 @@ func (self *SyncCommands) FetchBackgroundCmdObj() oscommands.ICmdObj {
 		ToArgv()
 
 	cmdObj := self.cmd.New(cmdArgs)
-	cmdObj.DontLog().FailOnCredentialRequest()
+	cmdObj.DontLog().FailOnCredentialRequest().
+		WithTimeout(time.Duration(self.UserConfig.Refresher.FetchTimeout) * time.Second)
 	return cmdObj
 }
 
@@ -77,17 +173,38 @@ func (self *SyncCommands) FetchBackground() error {
 	return self.FetchBackgroundCmdObj().Run()
 }
 
+// How a branch is pulled. Lazygit remembers the strategy chosen for a branch
+// in the git config, see SetPullStrategy.
+type PullStrategy string
+
+const (
+	// Whatever the git config says, e.g. pull.rebase
+	PullStrategyDefault         PullStrategy = ""
+	PullStrategyMerge           PullStrategy = "merge"
+	PullStrategyRebase          PullStrategy = "rebase"
+	PullStrategyRebaseAutostash PullStrategy = "rebase-autostash"
+	PullStrategyFastForwardOnly PullStrategy = "ff-only"
+)
+
+func (self PullStrategy) IsRebase() bool {
+	return self == PullStrategyRebase || self == PullStrategyRebaseAutostash
+}
+
 type PullOptions struct {
 	RemoteName      string
 	BranchName      string
 	FastForwardOnly bool
+	Strategy        PullStrategy
 	WorktreeGitDir  string
 }
 
-func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
+func (self *SyncCommands) PullCmdObj(task gocui.Task, opts PullOptions) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("pull").
 		Arg("--no-edit").
-		ArgIf(opts.FastForwardOnly, "--ff-only").
+		ArgIf(opts.Strategy == PullStrategyMerge, "--no-rebase").
+		ArgIf(opts.Strategy.IsRebase(), "--rebase").
+		ArgIf(opts.Strategy == PullStrategyRebaseAutostash, "--autostash").
+		ArgIf(opts.FastForwardOnly || opts.Strategy == PullStrategyFastForwardOnly, "--ff-only").
 		ArgIf(opts.RemoteName != "", opts.RemoteName).
 		ArgIf(opts.BranchName != "", opts.BranchName).
 		GitDirIf(opts.WorktreeGitDir != "", opts.WorktreeGitDir).
@@ -95,7 +212,75 @@ func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
 
 	// setting GIT_SEQUENCE_EDITOR to ':' as a way of skipping it, in case the user
 	// has 'pull.rebase = interactive' configured.
-	return self.cmd.New(cmdArgs).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest(task).Run()
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_SEQUENCE_EDITOR=:").PromptOnCredentialRequest(task)
+}
+
+func (self *SyncCommands) Pull(task gocui.Task, opts PullOptions) error {
+	return self.PullCmdObj(task, opts).Run()
+}
+
+// The strategy is stored in lazygit's own key, because there's nothing in git
+// for fast-forward-only or autostash per branch. branch.<name>.rebase is set
+// too, so that pulling the branch outside of lazygit behaves the same as far as
+// possible.
+func pullStrategyConfigKey(branchName string) string {
+	return fmt.Sprintf("branch.%s.lazygitPullStrategy", branchName)
+}
+
+func rebaseConfigKey(branchName string) string {
+	return fmt.Sprintf("branch.%s.rebase", branchName)
+}
+
+// Returns the strategy remembered for the branch. If there's none, but
+// branch.<name>.rebase is set, the strategy follows from that.
+func (self *SyncCommands) GetPullStrategy(branchName string) PullStrategy {
+	if strategy := self.getConfigValue(pullStrategyConfigKey(branchName)); strategy != "" {
+		return PullStrategy(strategy)
+	}
+
+	switch self.getConfigValue(rebaseConfigKey(branchName)) {
+	case "":
+		return PullStrategyDefault
+	case "false":
+		return PullStrategyMerge
+	default:
+		// 'true', 'merges' and 'interactive'
+		return PullStrategyRebase
+	}
+}
+
+func (self *SyncCommands) SetPullStrategy(branchName string, strategy PullStrategy) error {
+	if strategy == PullStrategyDefault {
+		for _, key := range []string{pullStrategyConfigKey(branchName), rebaseConfigKey(branchName)} {
+			// git config exits with 5 if the key isn't set, which is fine
+			_ = self.cmd.New(NewGitCmd("config").Arg("--unset", key).ToArgv()).Run()
+		}
+		return nil
+	}
+
+	cmdArgs := NewGitCmd("config").
+		Arg(rebaseConfigKey(branchName), lo.Ternary(strategy.IsRebase(), "true", "false")).
+		ToArgv()
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	cmdArgs = NewGitCmd("config").
+		Arg(pullStrategyConfigKey(branchName), string(strategy)).
+		ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// We don't use the cached git config here, because the value changes when the
+// user picks another strategy
+func (self *SyncCommands) getConfigValue(key string) string {
+	cmdArgs := NewGitCmd("config").
+		Arg("--get", key).
+		ToArgv()
+
+	// git config exits with 1 when the key isn't set
+	output, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output)
 }
 
 func (self *SyncCommands) FastForward(
@@ -112,10 +297,112 @@ func (self *SyncCommands) FastForward(
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
-func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error {
+func (self *SyncCommands) FetchRemoteCmdObj(task gocui.Task, remoteName string) oscommands.ICmdObj {
+	return self.fetchRemoteCmdObj(task, remoteName, false)
+}
+
+// With appendFetchHead, the fetched refs are added to .git/FETCH_HEAD instead
+// of replacing what it holds
+func (self *SyncCommands) fetchRemoteCmdObj(task gocui.Task, remoteName string, appendFetchHead bool) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("fetch").
+		ArgIf(oscommands.WantsProgress(task), "--progress").
+		ArgIf(appendFetchHead, "--append").
 		Arg(remoteName).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
+}
+
+func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error {
+	return self.FetchRemoteCmdObj(task, remoteName).Run()
+}
+
+type FetchResult struct {
+	Remote string
+	// The remote branches and tags that were updated
+	Updates []*RefUpdate
+	Err     error
+}
+
+// Fetches the remotes at the same time, returning a result per remote in the
+// given order. If the task reports progress, the progress of each remote is
+// labelled with its name. Like git fetch --multiple, this empties FETCH_HEAD
+// first and lets every fetch append to it, so that they don't overwrite each
+// other's refs.
+func (self *SyncCommands) FetchRemotes(task gocui.Task, remoteNames []string) []*FetchResult {
+	results := make([]*FetchResult, len(remoteNames))
+
+	appendFetchHead := len(remoteNames) > 1
+	if appendFetchHead {
+		// there's nothing to empty if it doesn't exist yet
+		_ = os.Truncate(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "FETCH_HEAD"), 0)
+	}
+
+	var wg sync.WaitGroup
+	for i, remoteName := range remoteNames {
+		i, remoteName := i, remoteName
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			remoteTask := task
+			if reporter, ok := task.(oscommands.ProgressReporter); ok {
+				remoteTask = labelledProgressTask{Task: task, reporter: reporter, label: remoteName}
+			}
+
+			// git fetch prints the updated refs to stderr
+			_, stderr, err := self.fetchRemoteCmdObj(remoteTask, remoteName, appendFetchHead).RunWithOutputs()
+			results[i] = &FetchResult{
+				Remote:  remoteName,
+				Updates: parseFetchOutput(stderr),
+				Err:     err,
+			}
+		})
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Labels the progress reported by a command, so that you can tell the
+// progress of commands that run at the same time apart
+type labelledProgressTask struct {
+	gocui.Task
+	reporter oscommands.ProgressReporter
+	label    string
+}
+
+func (self labelledProgressTask) ReportProgress(progress oscommands.Progress) {
+	progress.Label = self.label
+	self.reporter.ReportProgress(progress)
+}
+
+// e.g. ' * [new branch]      feature    -> origin/feature' or
+// ' + abc123...def456     master     -> origin/master  (forced update)'
+var fetchRefUpdateRegex = regexp.MustCompile(`^ (.) (\[[^\]]*\]|\S+)\s+(\S+)\s+-> (\S+)(?:\s+\((.*)\))?\s*$`)
+
+// Parses the lines that git fetch prints for every updated ref. 'git fetch
+// --porcelain' would be easier to parse, but it requires git 2.41.
+func parseFetchOutput(output string) []*RefUpdate {
+	updates := []*RefUpdate{}
+	for _, line := range strings.Split(output, "\n") {
+		match := fetchRefUpdateRegex.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			continue
+		}
+
+		summary := match[2]
+		if match[5] != "" {
+			summary += " (" + match[5] + ")"
+		}
+
+		updates = append(updates, &RefUpdate{
+			Flag:    match[1][0],
+			From:    lo.Ternary(match[3] == "(none)", "", match[3]),
+			To:      match[4],
+			Summary: summary,
+		})
+	}
+
+	return updates
 }
@@ -1,7 +1,9 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -83,18 +85,151 @@ func TestSyncPush(t *testing.T) {
 				assert.EqualValues(t, "Must specify a remote if specifying a branch", err.Error())
 			},
 		},
+		{
+			testName: "Push with force-if-includes",
+			opts: PushOpts{
+				Force:           true,
+				ForceIfIncludes: true,
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease", "--force-if-includes"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with force-if-includes but without force",
+			opts: PushOpts{
+				ForceIfIncludes: true,
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push refspecs atomically with push options",
+			opts: PushOpts{
+				UpstreamRemote: "origin",
+				Refspecs:       []string{"feature:review", "refs/tags/v1.0"},
+				Atomic:         true,
+				PushOptions:    []string{"ci.skip", "merge_request.create"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{
+					"git", "push", "--atomic", "--push-option=ci.skip", "--push-option=merge_request.create",
+					"origin", "feature:review", "refs/tags/v1.0",
+				})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push dry run",
+			opts: PushOpts{
+				UpstreamRemote: "origin",
+				UpstreamBranch: "master",
+				DryRun:         true,
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--dry-run", "--porcelain", "origin", "master"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with refspecs but no remote",
+			opts: PushOpts{
+				Refspecs: []string{"feature:review"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Error(t, err)
+				assert.EqualValues(t, "Must specify a remote if specifying refspecs", err.Error())
+			},
+		},
 	}
 
 	for _, s := range scenarios {
 		s := s
 		t.Run(s.testName, func(t *testing.T) {
-			instance := buildSyncCommands(commonDeps{})
+			instance := buildSyncCommands(commonDeps{gitVersion: &GitVersion{2, 30, 0, ""}})
 			task := gocui.NewFakeTask()
 			s.test(instance.PushCmdObj(task, s.opts))
 		})
 	}
 }
 
+func TestSyncPushForceIfIncludesWithOldGit(t *testing.T) {
+	instance := buildSyncCommands(commonDeps{gitVersion: &GitVersion{2, 29, 0, ""}})
+	cmdObj, err := instance.PushCmdObj(gocui.NewFakeTask(), PushOpts{Force: true, ForceIfIncludes: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"git", "push", "--force-with-lease"}, cmdObj.Args())
+}
+
+func TestSyncPushDryRun(t *testing.T) {
+	type scenario struct {
+		testName        string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedUpdates []*RefUpdate
+		expectedError   string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "updates",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"push", "--dry-run", "--porcelain", "origin", "master", "v1.0"},
+					"To ../origin\n"+
+						" \trefs/heads/master:refs/heads/master\tabc123..def456\n"+
+						"*\trefs/tags/v1.0:refs/tags/v1.0\t[new tag]\n"+
+						"+\trefs/heads/other:refs/heads/other\tabc123...def456 (forced update)\n"+
+						"-\t:refs/heads/gone\t[deleted]\n"+
+						"=\trefs/heads/same:refs/heads/same\t[up to date]\n"+
+						"Done\n",
+					nil),
+			expectedUpdates: []*RefUpdate{
+				{Flag: ' ', From: "refs/heads/master", To: "refs/heads/master", Summary: "abc123..def456"},
+				{Flag: '*', From: "refs/tags/v1.0", To: "refs/tags/v1.0", Summary: "[new tag]"},
+				{Flag: '+', From: "refs/heads/other", To: "refs/heads/other", Summary: "abc123...def456 (forced update)"},
+				{Flag: '-', From: "", To: "refs/heads/gone", Summary: "[deleted]"},
+				{Flag: '=', From: "refs/heads/same", To: "refs/heads/same", Summary: "[up to date]"},
+			},
+		},
+		{
+			testName: "rejected update",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"push", "--dry-run", "--porcelain", "origin", "master", "v1.0"},
+					"To ../origin\n!\trefs/heads/master:refs/heads/master\t[rejected] (fetch first)\nDone\n",
+					errors.New("failed to push some refs")),
+			expectedUpdates: []*RefUpdate{
+				{Flag: '!', From: "refs/heads/master", To: "refs/heads/master", Summary: "[rejected] (fetch first)"},
+			},
+		},
+		{
+			testName: "error",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"push", "--dry-run", "--porcelain", "origin", "master", "v1.0"},
+					"", errors.New("'origin' does not appear to be a git repository")),
+			expectedError: "'origin' does not appear to be a git repository",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{runner: s.runner})
+			updates, err := instance.PushDryRun(gocui.NewFakeTask(), PushOpts{
+				UpstreamRemote: "origin",
+				Refspecs:       []string{"master", "v1.0"},
+			})
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedUpdates, updates)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestSyncFetch(t *testing.T) {
 	type scenario struct {
 		testName       string
@@ -149,6 +284,7 @@ func TestSyncFetchBackground(t *testing.T) {
 				assert.False(t, cmdObj.ShouldLog())
 				assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.FAIL)
 				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch"})
+				assert.Equal(t, 120*time.Second, cmdObj.GetTimeout())
 			},
 		},
 		{
@@ -158,6 +294,7 @@ func TestSyncFetchBackground(t *testing.T) {
 				assert.False(t, cmdObj.ShouldLog())
 				assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.FAIL)
 				assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--all"})
+				assert.Equal(t, 120*time.Second, cmdObj.GetTimeout())
 			},
 		},
 	}
@@ -171,3 +308,188 @@ func TestSyncFetchBackground(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncPullCmdObj(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		opts         PullOptions
+		expectedArgs []string
+	}{
+		{
+			testName:     "default strategy",
+			opts:         PullOptions{},
+			expectedArgs: []string{"git", "pull", "--no-edit"},
+		},
+		{
+			testName:     "merge",
+			opts:         PullOptions{Strategy: PullStrategyMerge},
+			expectedArgs: []string{"git", "pull", "--no-edit", "--no-rebase"},
+		},
+		{
+			testName:     "rebase",
+			opts:         PullOptions{Strategy: PullStrategyRebase},
+			expectedArgs: []string{"git", "pull", "--no-edit", "--rebase"},
+		},
+		{
+			testName:     "rebase with autostash",
+			opts:         PullOptions{Strategy: PullStrategyRebaseAutostash},
+			expectedArgs: []string{"git", "pull", "--no-edit", "--rebase", "--autostash"},
+		},
+		{
+			testName:     "fast-forward only",
+			opts:         PullOptions{Strategy: PullStrategyFastForwardOnly, RemoteName: "origin", BranchName: "master"},
+			expectedArgs: []string{"git", "pull", "--no-edit", "--ff-only", "origin", "master"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{})
+			assert.Equal(t, s.expectedArgs, instance.PullCmdObj(gocui.NewFakeTask(), s.opts).Args())
+		})
+	}
+}
+
+func TestSyncGetPullStrategy(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected PullStrategy
+	}{
+		{
+			testName: "remembered by lazygit",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.lazygitPullStrategy"}, "ff-only\n", nil),
+			expected: PullStrategyFastForwardOnly,
+		},
+		{
+			testName: "rebase set outside of lazygit",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.lazygitPullStrategy"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.rebase"}, "interactive\n", nil),
+			expected: PullStrategyRebase,
+		},
+		{
+			testName: "merge set outside of lazygit",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.lazygitPullStrategy"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.rebase"}, "false\n", nil),
+			expected: PullStrategyMerge,
+		},
+		{
+			testName: "nothing set",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.lazygitPullStrategy"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"config", "--get", "branch.feature.rebase"}, "", errors.New("exit status 1")),
+			expected: PullStrategyDefault,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{runner: s.runner})
+			assert.Equal(t, s.expected, instance.GetPullStrategy("feature"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSyncSetPullStrategy(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		strategy PullStrategy
+		runner   *oscommands.FakeCmdObjRunner
+	}{
+		{
+			testName: "rebase with autostash",
+			strategy: PullStrategyRebaseAutostash,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "branch.feature.rebase", "true"}, "", nil).
+				ExpectGitArgs([]string{"config", "branch.feature.lazygitPullStrategy", "rebase-autostash"}, "", nil),
+		},
+		{
+			testName: "fast-forward only",
+			strategy: PullStrategyFastForwardOnly,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "branch.feature.rebase", "false"}, "", nil).
+				ExpectGitArgs([]string{"config", "branch.feature.lazygitPullStrategy", "ff-only"}, "", nil),
+		},
+		{
+			testName: "default",
+			strategy: PullStrategyDefault,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--unset", "branch.feature.lazygitPullStrategy"}, "", nil).
+				ExpectGitArgs([]string{"config", "--unset", "branch.feature.rebase"}, "", errors.New("exit status 5")),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSyncCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.SetPullStrategy("feature", s.strategy))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestParseFetchOutput(t *testing.T) {
+	output := "From ../origin\n" +
+		"   abc1234..def5678  master     -> origin/master\n" +
+		" + abc1234...def5678 forced     -> origin/forced  (forced update)\n" +
+		" * [new branch]      feature    -> origin/feature\n" +
+		" - [deleted]         (none)     -> origin/gone\n" +
+		" * [new tag]         v1.0       -> v1.0\n" +
+		" ! [rejected]        v2.0       -> v2.0  (would clobber existing tag)\n"
+
+	assert.Equal(t, []*RefUpdate{
+		{Flag: ' ', From: "master", To: "origin/master", Summary: "abc1234..def5678"},
+		{Flag: '+', From: "forced", To: "origin/forced", Summary: "abc1234...def5678 (forced update)"},
+		{Flag: '*', From: "feature", To: "origin/feature", Summary: "[new branch]"},
+		{Flag: '-', From: "", To: "origin/gone", Summary: "[deleted]"},
+		{Flag: '*', From: "v1.0", To: "v1.0", Summary: "[new tag]"},
+		{Flag: '!', From: "v2.0", To: "v2.0", Summary: "[rejected] (would clobber existing tag)"},
+	}, parseFetchOutput(output))
+}
+
+func TestSyncFetchRemotes(t *testing.T) {
+	// the remotes are fetched at the same time, so the order of the commands
+	// isn't fixed
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "--append", "origin"}, "", nil).
+		ExpectGitArgs([]string{"fetch", "--append", "upstream"}, "", errors.New("Could not read from remote repository."))
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	results := instance.FetchRemotes(gocui.NewFakeTask(), []string{"origin", "upstream"})
+
+	assert.Len(t, results, 2)
+	assert.Equal(t, "origin", results[0].Remote)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "upstream", results[1].Remote)
+	assert.EqualError(t, results[1].Err, "Could not read from remote repository.")
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncFetchRemotesSingleRemote(t *testing.T) {
+	// with only one fetch there's nothing to append to
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "origin"}, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	results := instance.FetchRemotes(gocui.NewFakeTask(), []string{"origin"})
+
+	assert.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncFetchRemoteCmdObj(t *testing.T) {
+	instance := buildSyncCommands(commonDeps{})
+
+	cmdObj := instance.FetchRemoteCmdObj(gocui.NewFakeTask(), "origin")
+
+	assert.Equal(t, []string{"git", "fetch", "origin"}, cmdObj.Args())
+	assert.Equal(t, oscommands.PROMPT, cmdObj.GetCredentialStrategy())
+}
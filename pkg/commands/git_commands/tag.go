@@ -1,6 +1,14 @@
 package git_commands
 
-import "github.com/jesseduffield/gocui"
+import (
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
 
 type TagCommands struct {
 	*GitCommon
@@ -23,13 +31,22 @@ func (self *TagCommands) CreateLightweight(tagName string, ref string, force boo
 }
 
 func (self *TagCommands) CreateAnnotated(tagName, ref, msg string, force bool) error {
+	return self.CreateAnnotatedCmdObj(tagName, ref, msg, force, false).Run()
+}
+
+// CreateAnnotatedCmdObj returns the command for creating an annotated tag. If
+// sign is true the tag is signed with the user's GPG or SSH key, which may
+// prompt for a passphrase, so the caller is expected to run it via the
+// GpgHelper.
+func (self *TagCommands) CreateAnnotatedCmdObj(tagName, ref, msg string, force bool, sign bool) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("tag").Arg(tagName).
 		ArgIf(force, "--force").
+		ArgIf(sign, "--sign").
 		ArgIf(len(ref) > 0, ref).
 		Arg("-m", msg).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs)
 }
 
 func (self *TagCommands) HasTag(tagName string) bool {
@@ -48,9 +65,177 @@ func (self *TagCommands) LocalDelete(tagName string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// LocalDeleteMultiple deletes several local tags at once
+func (self *TagCommands) LocalDeleteMultiple(tagNames []string) error {
+	cmdArgs := NewGitCmd("tag").Arg("-d").Arg(tagNames...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the sha of the tag object for an annotated tag, or of the commit for
+// a lightweight tag
+func (self *TagCommands) ObjectSha(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").Arg("refs/tags/" + tagName).
+		ToArgv()
+
+	sha, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(sha), err
+}
+
+// Restore recreates a deleted tag from the sha returned by ObjectSha, keeping
+// the message of an annotated tag
+func (self *TagCommands) Restore(tagName string, objectSha string) error {
+	cmdArgs := NewGitCmd("update-ref").Arg("refs/tags/"+tagName, objectSha).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// VerifyCmdObj returns the command that checks the signature of a tag, which
+// outputs what gpg (or ssh) reports. It fails if the tag is unsigned or the
+// signature is invalid.
+func (self *TagCommands) VerifyCmdObj(tagName string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("verify-tag").Arg("--", tagName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string) error {
 	cmdArgs := NewGitCmd("push").Arg(remoteName, "tag", tagName).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// PushMultiple pushes several tags at once. Force is needed when a tag
+// already exists on the remote and points somewhere else.
+func (self *TagCommands) PushMultiple(task gocui.Task, remoteName string, tagNames []string, force bool) error {
+	cmdArgs := NewGitCmd("push").
+		ArgIf(force, "--force").
+		Arg(remoteName).
+		Arg(tagRefs(tagNames)...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// FetchMultiple fetches the given tags from the remote, overwriting any local
+// tags of the same name
+func (self *TagCommands) FetchMultiple(task gocui.Task, remoteName string, tagNames []string) error {
+	cmdArgs := NewGitCmd("fetch").
+		Arg("--force", "--no-tags", remoteName).
+		Arg(lo.Map(tagRefs(tagNames), func(ref string, _ int) string {
+			return ref + ":" + ref
+		})...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+type TagSyncStatus int
+
+const (
+	TagLocalOnly TagSyncStatus = iota
+	TagRemoteOnly
+	// the tag exists both locally and on the remote, but points to different
+	// objects
+	TagDiffers
+)
+
+type TagSyncInfo struct {
+	Name   string
+	Status TagSyncStatus
+}
+
+// CompareWithRemote lists the tags that aren't in sync between the local repo
+// and the given remote, sorted by name. Tags that are identical on both sides
+// are omitted.
+func (self *TagCommands) CompareWithRemote(remoteName string) ([]*TagSyncInfo, error) {
+	localTags, err := self.localTagObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteTags, err := self.remoteTagObjects(remoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	return compareTags(localTags, remoteTags), nil
+}
+
+func compareTags(localTags map[string]string, remoteTags map[string]string) []*TagSyncInfo {
+	result := []*TagSyncInfo{}
+	for name, localSha := range localTags {
+		remoteSha, ok := remoteTags[name]
+		if !ok {
+			result = append(result, &TagSyncInfo{Name: name, Status: TagLocalOnly})
+		} else if remoteSha != localSha {
+			result = append(result, &TagSyncInfo{Name: name, Status: TagDiffers})
+		}
+	}
+	for name := range remoteTags {
+		if _, ok := localTags[name]; !ok {
+			result = append(result, &TagSyncInfo{Name: name, Status: TagRemoteOnly})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// returns a map from tag name to the sha of the tag object (for annotated
+// tags) or of the tagged commit (for lightweight tags)
+func (self *TagCommands) localTagObjects() (map[string]string, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(objectname) %(refname)", "refs/tags").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTagObjects(output), nil
+}
+
+func (self *TagCommands) remoteTagObjects(remoteName string) (map[string]string, error) {
+	// --refs omits the peeled 'refs/tags/<name>^{}' entries of annotated tags.
+	// We can't handle credential prompts while also capturing the output, so
+	// we tell git to fail instead of prompting.
+	cmdArgs := NewGitCmd("ls-remote").
+		Arg("--tags", "--refs", remoteName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).AddEnvVars("GIT_TERMINAL_PROMPT=0").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTagObjects(output), nil
+}
+
+// parses lines of the form '<sha> refs/tags/<name>', where the separator can be
+// a space or a tab
+func parseTagObjects(output string) map[string]string {
+	result := map[string]string{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "refs/tags/") {
+			continue
+		}
+		result[strings.TrimPrefix(fields[1], "refs/tags/")] = fields[0]
+	}
+	return result
+}
+
+func tagRefs(tagNames []string) []string {
+	return lo.Map(tagNames, func(tagName string, _ int) string {
+		return "refs/tags/" + tagName
+	})
+}
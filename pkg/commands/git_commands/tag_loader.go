@@ -1,7 +1,8 @@
 package git_commands
 
 import (
-	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -25,32 +26,71 @@ func NewTagLoader(
 	}
 }
 
+// The fields we ask for, separated by null bytes. For annotated tags objecttype
+// is "tag" and *objecttype is the type of the tagged object; for lightweight
+// tags objecttype is the type of the tagged object and *objecttype is empty.
+var tagFormatFields = []string{
+	"%(refname:strip=2)",
+	"%(objecttype)",
+	"%(*objecttype)",
+	"%(taggername)",
+	"%(taggeremail)",
+	"%(creatordate:unix)",
+	"%(if)%(contents:signature)%(then)signed%(end)",
+	"%(contents:lines=1)",
+}
+
 func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
+	// get tags, sorted by creation date (descending)
 	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	cmdArgs := NewGitCmd("tag").Arg("--list", "-n", "--sort=-creatordate").ToArgv()
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--sort=-creatordate", "--format="+strings.Join(tagFormatFields, "%00"), "refs/tags").
+		ToArgv()
 	tagsOutput, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
 	}
 
-	split := utils.SplitLines(tagsOutput)
+	tags := lo.FilterMap(utils.SplitLines(tagsOutput), func(line string, _ int) (*models.Tag, bool) {
+		return parseTagLine(line)
+	})
+
+	return tags, nil
+}
+
+func parseTagLine(line string) (*models.Tag, bool) {
+	split := strings.Split(line, "\x00")
+	if len(split) != len(tagFormatFields) {
+		return nil, false
+	}
 
-	lineRegex := regexp.MustCompile(`^([^\s]+)(\s+)?(.*)$`)
+	name := split[0]
+	objectType := split[1]
+	peeledObjectType := split[2]
+	taggerName := split[3]
+	taggerEmail := split[4]
+	date, _ := strconv.ParseInt(split[5], 10, 64)
+	signed := split[6]
+	message := split[7]
 
-	tags := lo.Map(split, func(line string, _ int) *models.Tag {
-		matches := lineRegex.FindStringSubmatch(line)
-		tagName := matches[1]
-		message := ""
-		if len(matches) > 3 {
-			message = matches[3]
-		}
+	isAnnotated := objectType == "tag"
+	targetType := objectType
+	if isAnnotated {
+		targetType = peeledObjectType
+	}
 
-		return &models.Tag{
-			Name:    tagName,
-			Message: message,
-		}
-	})
+	tagger := ""
+	if taggerName != "" {
+		tagger = strings.TrimSpace(taggerName + " " + taggerEmail)
+	}
 
-	return tags, nil
+	return &models.Tag{
+		Name:        name,
+		Message:     message,
+		IsAnnotated: isAnnotated,
+		Tagger:      tagger,
+		Date:        date,
+		TargetType:  targetType,
+		IsSigned:    signed != "",
+	}, true
 }
@@ -9,10 +9,16 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+const tagsOutput = "tag1\x00tag\x00commit\x00Jesse Duffield\x00<jesse@example.com>\x001700000000\x00\x00this is my message\n" +
+	"tag2\x00commit\x00\x00\x00\x001690000000\x00\x00\n" +
+	"tag3\x00tag\x00tree\x00Jesse Duffield\x00<jesse@example.com>\x001680000000\x00signed\x00this is my other message\n"
+
+var tagsCmdArgs = []string{
+	"for-each-ref",
+	"--sort=-creatordate",
+	"--format=%(refname:strip=2)%00%(objecttype)%00%(*objecttype)%00%(taggername)%00%(taggeremail)%00%(creatordate:unix)%00%(if)%(contents:signature)%(then)signed%(end)%00%(contents:lines=1)",
+	"refs/tags",
+}
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
@@ -26,18 +32,38 @@ func TestGetTags(t *testing.T) {
 		{
 			testName: "should return no tags if there are none",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, "", nil),
+				ExpectGitArgs(tagsCmdArgs, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
 			testName: "should return tags if present",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, tagsOutput, nil),
+				ExpectGitArgs(tagsCmdArgs, tagsOutput, nil),
 			expectedTags: []*models.Tag{
-				{Name: "tag1", Message: "this is my message"},
-				{Name: "tag2", Message: ""},
-				{Name: "tag3", Message: "this is my other message"},
+				{
+					Name:        "tag1",
+					Message:     "this is my message",
+					IsAnnotated: true,
+					Tagger:      "Jesse Duffield <jesse@example.com>",
+					Date:        1700000000,
+					TargetType:  "commit",
+				},
+				{
+					Name:       "tag2",
+					Message:    "",
+					Date:       1690000000,
+					TargetType: "commit",
+				},
+				{
+					Name:        "tag3",
+					Message:     "this is my other message",
+					IsAnnotated: true,
+					Tagger:      "Jesse Duffield <jesse@example.com>",
+					Date:        1680000000,
+					TargetType:  "tree",
+					IsSigned:    true,
+				},
 			},
 			expectedError: nil,
 		},
@@ -0,0 +1,100 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagCreateAnnotatedCmdObj(t *testing.T) {
+	type scenario struct {
+		testName string
+		ref      string
+		force    bool
+		sign     bool
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "annotated tag",
+			ref:      "",
+			force:    false,
+			sign:     false,
+			expected: []string{"git", "tag", "v1.0", "-m", "message"},
+		},
+		{
+			testName: "signed tag on a ref",
+			ref:      "abc123",
+			force:    false,
+			sign:     true,
+			expected: []string{"git", "tag", "v1.0", "--sign", "abc123", "-m", "message"},
+		},
+		{
+			testName: "force signed tag",
+			ref:      "",
+			force:    true,
+			sign:     true,
+			expected: []string{"git", "tag", "v1.0", "--force", "--sign", "-m", "message"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildTagCommands(commonDeps{})
+			cmdObj := instance.CreateAnnotatedCmdObj("v1.0", s.ref, "message", s.force, s.sign)
+			assert.Equal(t, s.expected, cmdObj.Args())
+		})
+	}
+}
+
+func TestTagPushMultiple(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "--force", "origin", "refs/tags/v1.0", "refs/tags/v2.0"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PushMultiple(nil, "origin", []string{"v1.0", "v2.0"}, true))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagRestore(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "refs/tags/v1.0"}, "abc123\n", nil).
+		ExpectGitArgs([]string{"update-ref", "refs/tags/v1.0", "abc123"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	sha, err := instance.ObjectSha("v1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", sha)
+	assert.NoError(t, instance.Restore("v1.0", sha))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagFetchMultiple(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "--force", "--no-tags", "origin", "refs/tags/v1.0:refs/tags/v1.0"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.FetchMultiple(nil, "origin", []string{"v1.0"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagCompareWithRemote(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(objectname) %(refname)", "refs/tags"},
+			"aaa refs/tags/same\nbbb refs/tags/local-only\nccc refs/tags/different\n", nil).
+		ExpectGitArgs([]string{"ls-remote", "--tags", "--refs", "origin"},
+			"aaa\trefs/tags/same\nddd\trefs/tags/different\neee\trefs/tags/remote-only\n", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	result, err := instance.CompareWithRemote("origin")
+	assert.NoError(t, err)
+	assert.Equal(t, []*TagSyncInfo{
+		{Name: "different", Status: TagDiffers},
+		{Name: "local-only", Status: TagLocalOnly},
+		{Name: "remote-only", Status: TagRemoteOnly},
+	}, result)
+	runner.CheckForMissingCalls()
+}
@@ -3,10 +3,13 @@ package git_commands
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -217,6 +220,140 @@ func (self *WorkingTreeCommands) DiscardUnstagedFileChanges(file *models.File) e
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// SnapshotChanges stores the current content of the given files in the
+// working tree (or of all files if no paths are passed), including untracked
+// ones, as a commit on top of HEAD that isn't referenced by any ref, like the
+// commits of a dropped stash entry. It doesn't touch the index or the working
+// tree. Returns an empty sha if the files have no changes.
+func (self *WorkingTreeCommands) SnapshotChanges(paths []string, message string) (string, error) {
+	// a temporary index, like the one `git stash` uses
+	indexPath := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index.lazygit-snapshot")
+	defer func() { _ = os.Remove(indexPath) }()
+	indexEnvVar := "GIT_INDEX_FILE=" + indexPath
+
+	// fails if there are no commits yet
+	headTree, err := self.cmd.New(
+		NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD^{tree}").ToArgv(),
+	).DontLog().RunWithOutput()
+	headTree = strings.TrimSpace(headTree)
+	hasHead := err == nil && headTree != ""
+
+	readTreeArgs := NewGitCmd("read-tree").ArgIfElse(hasHead, "HEAD", "--empty").ToArgv()
+	if err := self.cmd.New(readTreeArgs).AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+		return "", err
+	}
+
+	if len(paths) == 0 {
+		if err := self.cmd.New(NewGitCmd("add").Arg("--all").ToArgv()).AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+			return "", err
+		}
+	} else {
+		// git add fails for paths that match neither a file nor an index
+		// entry, e.g. a file deleted on both sides of a merge, so deleted files
+		// are removed from the index separately
+		existingPaths, deletedPaths := []string{}, []string{}
+		for _, path := range paths {
+			if exists, _ := self.os.FileExists(path); exists {
+				existingPaths = append(existingPaths, path)
+			} else {
+				deletedPaths = append(deletedPaths, path)
+			}
+		}
+
+		if len(existingPaths) > 0 {
+			addArgs := NewGitCmd("add").Arg("--all", "--").Arg(existingPaths...).ToArgv()
+			if err := self.cmd.New(addArgs).AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+				return "", err
+			}
+		}
+
+		if len(deletedPaths) > 0 {
+			rmArgs := NewGitCmd("rm").Arg("--cached", "--quiet", "--ignore-unmatch", "-r", "--").Arg(deletedPaths...).ToArgv()
+			if err := self.cmd.New(rmArgs).AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	tree, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).AddEnvVars(indexEnvVar).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+	tree = strings.TrimSpace(tree)
+	if tree == headTree {
+		return "", nil
+	}
+
+	commitTreeArgs := NewGitCmd("commit-tree").
+		Arg(tree).
+		ArgIf(hasHead, "-p", "HEAD").
+		Arg("-m", message).
+		ToArgv()
+	sha, err := self.cmd.New(commitTreeArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(sha), err
+}
+
+type SnapshotFile struct {
+	Name string
+	// true if the file didn't exist when the snapshot was taken
+	Deleted bool
+}
+
+// SnapshotFiles returns the files whose content is stored in a snapshot
+// created by SnapshotChanges
+func (self *WorkingTreeCommands) SnapshotFiles(sha string) ([]*SnapshotFile, error) {
+	// not renameDetectionArgs: every path must be listed, so that it can be
+	// restored (or removed) on its own
+	cmdArgs := NewGitCmd("diff-tree").
+		Arg("-r", "--root", "--no-commit-id", "--no-renames", "--name-status", "-z", sha).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// e.g. 'M\x00file\x00D\x00other-file\x00'
+	fields := strings.Split(strings.TrimSuffix(output, "\x00"), "\x00")
+	files := []*SnapshotFile{}
+	for i := 0; i+1 < len(fields); i += 2 {
+		files = append(files, &SnapshotFile{Name: fields[i+1], Deleted: fields[i] == "D"})
+	}
+	return files, nil
+}
+
+// RestoreFilesFromSnapshot writes the files as they are in a snapshot created
+// by SnapshotChanges (or in any other commit) to the working tree, including
+// their mode, and removes the ones the snapshot doesn't contain. The index
+// isn't touched.
+func (self *WorkingTreeCommands) RestoreFilesFromSnapshot(sha string, paths []string) error {
+	lsTreeArgs := NewGitCmd("ls-tree").Arg("-r", "-z", "--name-only", sha, "--").Arg(paths...).ToArgv()
+	output, _, err := self.cmd.New(lsTreeArgs).DontLog().RunWithOutputs()
+	if err != nil {
+		return err
+	}
+	snapshotPaths := lo.Compact(strings.Split(output, "\x00"))
+
+	for _, path := range lo.Without(paths, snapshotPaths...) {
+		if exists, _ := self.os.FileExists(path); exists {
+			if err := self.os.RemoveFile(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(snapshotPaths) == 0 {
+		return nil
+	}
+
+	// checking out into a temporary index leaves the real one alone
+	indexPath := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index.lazygit-restore")
+	defer func() { _ = os.Remove(indexPath) }()
+
+	checkoutArgs := NewGitCmd("checkout").Arg(sha, "--").Arg(snapshotPaths...).ToArgv()
+	return self.cmd.New(checkoutArgs).AddEnvVars("GIT_INDEX_FILE=" + indexPath).Run()
+}
+
 // Ignore adds a file to the gitignore for the repo
 func (self *WorkingTreeCommands) Ignore(filename string) error {
 	return self.os.AppendLineToFile(".gitignore", filename)
@@ -253,6 +390,7 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg(fmt.Sprintf("--color=%s", colorArg)).
 		ArgIf(!plain && self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(self.diffOptionArgs(plain)...).
 		ArgIf(cached, "--cached").
 		ArgIf(noIndex, "--no-index").
 		Arg("--").
@@ -286,12 +424,15 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 		ArgIfElse(useExtDiff, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
+		// not renameDetectionArgs: the file must match its entry in the commit
+		// files list, which doesn't detect renames
 		Arg("--no-renames").
 		Arg(fmt.Sprintf("--color=%s", colorArg)).
 		Arg(from).
 		Arg(to).
 		ArgIf(reverse, "-R").
 		ArgIf(!plain && self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(self.diffOptionArgs(plain)...).
 		Arg("--").
 		Arg(fileName).
 		ToArgv()
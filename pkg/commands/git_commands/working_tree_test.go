@@ -2,6 +2,8 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-errors/errors"
@@ -213,6 +215,11 @@ func TestWorkingTreeDiff(t *testing.T) {
 		cached           bool
 		ignoreWhitespace bool
 		contextSize      int
+		wordDiff         string
+		diffAlgorithm    string
+		colorMoved       bool
+		renameThreshold  int
+		findCopies       bool
 		runner           *oscommands.FakeCmdObjRunner
 	}
 
@@ -303,6 +310,49 @@ func TestWorkingTreeDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=17", "--color=always", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			// we highlight the changed words ourselves, so that every line of
+			// the diff stays on a line of its own
+			testName: "Don't let git highlight changed words",
+			file: &models.File{
+				Name:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			contextSize: 3,
+			wordDiff:    "word",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName: "Show diff with diff options, still without renames",
+			file: &models.File{
+				Name:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			contextSize:     3,
+			diffAlgorithm:   "minimal",
+			colorMoved:      true,
+			renameThreshold: 40,
+			findCopies:      true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=always", "--diff-algorithm=minimal", "--color-moved", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName: "Plain diffs don't colour moved lines",
+			file: &models.File{
+				Name:             "test.txt",
+				HasStagedChanges: false,
+				Tracked:          true,
+			},
+			plain:         true,
+			contextSize:   3,
+			diffAlgorithm: "patience",
+			colorMoved:    true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=3", "--color=never", "--diff-algorithm=patience", "--", "test.txt"}, expectedResult, nil),
+		},
 	}
 
 	for _, s := range scenarios {
@@ -312,6 +362,11 @@ func TestWorkingTreeDiff(t *testing.T) {
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
+			appState.WordDiffMode = s.wordDiff
+			appState.DiffAlgorithm = s.diffAlgorithm
+			appState.ColorMovedInDiffView = s.colorMoved
+			appState.RenameThreshold = s.renameThreshold
+			appState.FindCopiesInDiffView = s.findCopies
 
 			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner, userConfig: userConfig, appState: appState})
 			result := instance.WorktreeFileDiff(s.file, s.plain, s.cached)
@@ -330,6 +385,9 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 		plain            bool
 		ignoreWhitespace bool
 		contextSize      int
+		diffAlgorithm    string
+		colorMoved       bool
+		renameThreshold  int
 		runner           *oscommands.FakeCmdObjRunner
 	}
 
@@ -369,6 +427,17 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--ignore-all-space", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			testName:        "Show diff with diff options, still without renames",
+			from:            "1234567890",
+			to:              "0987654321",
+			contextSize:     3,
+			diffAlgorithm:   "histogram",
+			colorMoved:      true,
+			renameThreshold: 40,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--diff-algorithm=histogram", "--color-moved", "--", "test.txt"}, expectedResult, nil),
+		},
 	}
 
 	for _, s := range scenarios {
@@ -378,6 +447,9 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
+			appState.DiffAlgorithm = s.diffAlgorithm
+			appState.ColorMovedInDiffView = s.colorMoved
+			appState.RenameThreshold = s.renameThreshold
 
 			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner, userConfig: userConfig, appState: appState})
 
@@ -462,6 +534,98 @@ func TestWorkingTreeDiscardUnstagedFileChanges(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeRestoreFilesFromSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	untracked := filepath.Join(dir, "untracked")
+	assert.NoError(t, os.WriteFile(untracked, []byte("content"), 0o644))
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-tree", "-r", "-z", "--name-only", "abc123", "--", file, untracked}, file+"\x00", nil).
+		ExpectGitArgs([]string{"checkout", "abc123", "--", file}, "", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner, removeFile: os.Remove})
+
+	assert.NoError(t, instance.RestoreFilesFromSnapshot("abc123", []string{file, untracked}))
+	// the snapshot doesn't contain the untracked file
+	assert.NoFileExists(t, untracked)
+	runner.CheckForMissingCalls()
+}
+
+func TestWorkingTreeSnapshotChanges(t *testing.T) {
+	existingFile := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(existingFile, []byte("content"), 0o644))
+
+	type scenario struct {
+		testName    string
+		paths       []string
+		runner      *oscommands.FakeCmdObjRunner
+		expectedSha string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "some files",
+			paths:    []string{existingFile, "deleted"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{tree}"}, "headtree\n", nil).
+				ExpectGitArgs([]string{"read-tree", "HEAD"}, "", nil).
+				ExpectGitArgs([]string{"add", "--all", "--", existingFile}, "", nil).
+				ExpectGitArgs([]string{"rm", "--cached", "--quiet", "--ignore-unmatch", "-r", "--", "deleted"}, "", nil).
+				ExpectGitArgs([]string{"write-tree"}, "tree\n", nil).
+				ExpectGitArgs([]string{"commit-tree", "tree", "-p", "HEAD", "-m", "Discard"}, "abc123\n", nil),
+			expectedSha: "abc123",
+		},
+		{
+			testName: "all files without any commits",
+			paths:    nil,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{tree}"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"read-tree", "--empty"}, "", nil).
+				ExpectGitArgs([]string{"add", "--all"}, "", nil).
+				ExpectGitArgs([]string{"write-tree"}, "tree\n", nil).
+				ExpectGitArgs([]string{"commit-tree", "tree", "-m", "Discard"}, "abc123\n", nil),
+			expectedSha: "abc123",
+		},
+		{
+			testName: "no changes",
+			paths:    []string{existingFile},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD^{tree}"}, "headtree\n", nil).
+				ExpectGitArgs([]string{"read-tree", "HEAD"}, "", nil).
+				ExpectGitArgs([]string{"add", "--all", "--", existingFile}, "", nil).
+				ExpectGitArgs([]string{"write-tree"}, "headtree\n", nil),
+			expectedSha: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			sha, err := instance.SnapshotChanges(s.paths, "Discard")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedSha, sha)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeSnapshotFiles(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"diff-tree", "-r", "--root", "--no-commit-id", "--no-renames", "--name-status", "-z", "abc123"},
+			"M\x00dir/file\x00D\x00deleted\x00A\x00untracked\x00", nil)
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+
+	files, err := instance.SnapshotFiles("abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, []*SnapshotFile{
+		{Name: "dir/file"},
+		{Name: "deleted", Deleted: true},
+		{Name: "untracked"},
+	}, files)
+	runner.CheckForMissingCalls()
+}
+
 func TestWorkingTreeDiscardAnyUnstagedFileChanges(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/fsmiamoto/git-todo-parser/todo"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -41,6 +42,28 @@ const (
 	DivergenceRight
 )
 
+// SignatureStatus is the result of verifying a commit's signature, as reported
+// by git's %G? placeholder
+type SignatureStatus string
+
+const (
+	// signature status wasn't loaded (see git.log.showSignatureStatus)
+	SignatureNotLoaded SignatureStatus = ""
+	SignatureNone      SignatureStatus = "N"
+	SignatureGood      SignatureStatus = "G"
+	SignatureBad       SignatureStatus = "B"
+	// good signature, but the validity of the key is unknown
+	SignatureUntrusted SignatureStatus = "U"
+	// good signature that has expired
+	SignatureExpired SignatureStatus = "X"
+	// good signature made by a key that has since expired
+	SignatureExpiredKey SignatureStatus = "Y"
+	// good signature made by a key that has since been revoked
+	SignatureRevokedKey SignatureStatus = "R"
+	// the signature can't be checked, e.g. because the key is missing
+	SignatureCannotCheck SignatureStatus = "E"
+)
+
 // Commit : A git commit
 type Commit struct {
 	Sha           string
@@ -54,6 +77,9 @@ type Commit struct {
 	UnixTimestamp int64
 	Divergence    Divergence // set to DivergenceNone unless we are showing the divergence view
 
+	SignatureStatus SignatureStatus
+	Signer          string // something like 'Jesse Duffield <jessedduffield@gmail.com>'
+
 	// SHAs of parent commits (will be multiple if it's a merge commit)
 	Parents []string
 }
@@ -93,6 +119,15 @@ func (c *Commit) IsMerge() bool {
 	return len(c.Parents) > 1
 }
 
+var stashSubjectRegexp = regexp.MustCompile(`^(WIP on|On) [^:]+: `)
+
+// IsStash returns true if this looks like a commit created by `git stash`:
+// a merge of the stashed-from commit and the index commit (plus optionally
+// the untracked files commit), with git's stash subject.
+func (c *Commit) IsStash() bool {
+	return c.IsMerge() && stashSubjectRegexp.MatchString(c.Name)
+}
+
 // returns true if this commit is not actually in the git log but instead
 // is from a TODO file for an interactive rebase.
 func (c *Commit) IsTODO() bool {
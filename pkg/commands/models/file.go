@@ -22,8 +22,26 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// whether the file is managed by git-lfs, and if so, what the working tree
+	// holds
+	LfsStatus LfsStatus
 }
 
+type LfsStatus int
+
+const (
+	// the file isn't managed by git-lfs
+	LfsNone LfsStatus = iota
+	// the working tree only has the pointer to the file's LFS object, because
+	// the object hasn't been downloaded
+	LfsPointer
+	// the working tree has the content of the file's LFS object
+	LfsSmudged
+	// the file is managed by git-lfs, but it isn't in the working tree
+	LfsAbsent
+)
+
 // sometimes we need to deal with either a node (which contains a file) or an actual file
 type IFile interface {
 	GetHasUnstagedChanges() bool
@@ -60,6 +78,10 @@ func (f *File) Description() string {
 	return f.Name
 }
 
+func (f *File) IsLfs() bool {
+	return f.LfsStatus != LfsNone
+}
+
 func (f *File) IsSubmodule(configs []*SubmoduleConfig) bool {
 	return f.SubmoduleConfig(configs) != nil
 }
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// LfsLock : A lock on a file that's managed by git-lfs, as reported by the LFS
+// server
+type LfsLock struct {
+	ID       string
+	Path     string
+	Owner    string
+	LockedAt time.Time
+}
@@ -6,6 +6,18 @@ type Tag struct {
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+	// true for annotated tags, false for lightweight tags
+	IsAnnotated bool
+	// name and email of whoever created the tag. Empty for lightweight tags
+	Tagger string
+	// unix timestamp of when an annotated tag was created, or the committer
+	// date of the tagged commit for lightweight tags
+	Date int64
+	// the type of object the tag points to (usually "commit")
+	TargetType string
+	// whether the tag carries a GPG or SSH signature. This says nothing about
+	// whether the signature is valid; use TagCommands.VerifyCmdObj for that
+	IsSigned bool
 }
 
 func (t *Tag) FullRefName() string {
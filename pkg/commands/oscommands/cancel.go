@@ -0,0 +1,117 @@
+package oscommands
+
+import (
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// Implemented by tasks that the user can cancel. If a command's task implements
+// it, the command is killed when the task is cancelled.
+type Cancellable interface {
+	Cancelled() <-chan struct{}
+}
+
+// Returned when a command was killed because its task was cancelled
+type CancelledError struct {
+	Command string
+}
+
+func (self *CancelledError) Error() string {
+	return fmt.Sprintf("'%s' was cancelled", self.Command)
+}
+
+// Returned when a command was killed because it took longer than its timeout
+type TimeoutError struct {
+	Command string
+	Timeout time.Duration
+}
+
+func (self *TimeoutError) Error() string {
+	return fmt.Sprintf("'%s' timed out after %s", self.Command, self.Timeout)
+}
+
+// Returns true if the error says that the command was terminated because it
+// was cancelled or timed out, rather than that it failed by itself
+func wasTerminated(err error) bool {
+	var cancelledErr *CancelledError
+	var timeoutErr *TimeoutError
+	return errors.As(err, &cancelledErr) || errors.As(err, &timeoutErr)
+}
+
+func canBeTerminated(cmdObj ICmdObj) bool {
+	_, cancellable := cmdObj.GetTask().(Cancellable)
+	return cancellable || cmdObj.GetTimeout() > 0
+}
+
+// Runs the command like cmd.Run(), but terminates it (and the processes it
+// started) if its task is cancelled or it runs into its timeout, in which case
+// the reason is returned instead of the command's error
+func (self *cmdObjRunner) runTerminable(cmdObj ICmdObj, cmd *exec.Cmd) error {
+	if !canBeTerminated(cmdObj) {
+		return cmd.Run()
+	}
+
+	PrepareForChildren(cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	stopWatching := self.terminateOnCancelOrTimeout(cmdObj, cmd)
+	err := cmd.Wait()
+	if killedErr := stopWatching(); killedErr != nil {
+		return killedErr
+	}
+	return err
+}
+
+// How long a terminated command gets to exit before it's killed
+const terminateTimeout = 5 * time.Second
+
+// Terminates the started command if its task is cancelled or it runs into its
+// timeout. The returned function must be called once the command has exited;
+// it returns the reason for terminating the command, if it was terminated.
+func (self *cmdObjRunner) terminateOnCancelOrTimeout(cmdObj ICmdObj, cmd *exec.Cmd) func() error {
+	var cancelled <-chan struct{}
+	if cancellable, ok := cmdObj.GetTask().(Cancellable); ok {
+		cancelled = cancellable.Cancelled()
+	}
+
+	timeout := cmdObj.GetTimeout()
+	if cancelled == nil && timeout <= 0 {
+		return func() error { return nil }
+	}
+
+	exited := make(chan struct{})
+	reason := make(chan error, 1)
+	go func() {
+		var timedOut <-chan time.Time
+		if timeout > 0 {
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			timedOut = timer.C
+		}
+
+		select {
+		case <-cancelled:
+			reason <- &CancelledError{Command: cmdObj.ToString()}
+		case <-timedOut:
+			reason <- &TimeoutError{Command: cmdObj.ToString(), Timeout: timeout}
+		case <-exited:
+			reason <- nil
+			return
+		}
+
+		self.log.Warnf("Terminating %s", cmdObj.ToString())
+		if err := self.terminateCmd(cmd, exited); err != nil {
+			self.log.Error(err)
+		}
+	}()
+
+	return func() error {
+		close(exited)
+		return <-reason
+	}
+}
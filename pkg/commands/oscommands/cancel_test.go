@@ -0,0 +1,102 @@
+//go:build !windows
+// +build !windows
+
+package oscommands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
+	"github.com/stretchr/testify/assert"
+)
+
+type cancellableTask struct {
+	*gocui.FakeTask
+	cancelled chan struct{}
+}
+
+func (self cancellableTask) Cancelled() <-chan struct{} {
+	return self.cancelled
+}
+
+func TestRunCancelledCommand(t *testing.T) {
+	task := cancellableTask{FakeTask: gocui.NewFakeTask(), cancelled: make(chan struct{})}
+	// the sleep is a child process of the shell, which is terminated too
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"sh", "-c", "sleep 10; true"}).
+		PromptOnCredentialRequest(task)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		close(task.cancelled)
+	}()
+
+	start := time.Now()
+	err := cmdObj.Run()
+
+	var cancelledErr *CancelledError
+	assert.True(t, errors.As(err, &cancelledErr))
+	assert.Equal(t, `sh -c "sleep 10; true"`, cancelledErr.Command)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRunCommandWithTimeout(t *testing.T) {
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"sleep", "10"}).
+		FailOnCredentialRequest().
+		WithTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	err := cmdObj.Run()
+
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.EqualError(t, err, "'sleep 10' timed out after 100ms")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRunCommandFinishingBeforeTimeout(t *testing.T) {
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"git", "--version"}).
+		FailOnCredentialRequest().
+		WithTimeout(10 * time.Second)
+
+	assert.NoError(t, cmdObj.Run())
+}
+
+func TestRunWithOutputCancelledCommand(t *testing.T) {
+	task := cancellableTask{FakeTask: gocui.NewFakeTask(), cancelled: make(chan struct{})}
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"sh", "-c", "echo started; sleep 10; true"}).
+		CancelWith(task)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		close(task.cancelled)
+	}()
+
+	start := time.Now()
+	output, err := cmdObj.RunWithOutput()
+
+	var cancelledErr *CancelledError
+	assert.True(t, errors.As(err, &cancelledErr))
+	assert.Equal(t, "", output)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRunAndProcessLinesCancelledCommand(t *testing.T) {
+	task := cancellableTask{FakeTask: gocui.NewFakeTask(), cancelled: make(chan struct{})}
+	cmdObj := NewDummyCmdObjBuilder(getRunner()).New([]string{"sh", "-c", "echo started; sleep 10; echo done"}).
+		CancelWith(task)
+
+	lines := []string{}
+	start := time.Now()
+	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		lines = append(lines, line)
+		close(task.cancelled)
+		return false, nil
+	})
+
+	var cancelledErr *CancelledError
+	assert.True(t, errors.As(err, &cancelledErr))
+	assert.Equal(t, []string{"started"}, lines)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -3,6 +3,7 @@ package oscommands
 import (
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/samber/lo"
@@ -69,6 +70,15 @@ type ICmdObj interface {
 	GetCredentialStrategy() CredentialStrategy
 	GetTask() gocui.Task
 
+	// Terminates the command when the task is cancelled, if it's Cancellable.
+	// Commands with a credential strategy get their task from
+	// PromptOnCredentialRequest instead.
+	CancelWith(task gocui.Task) ICmdObj
+
+	// Kills the command if it's still running after the given duration
+	WithTimeout(timeout time.Duration) ICmdObj
+	GetTimeout() time.Duration
+
 	Clone() ICmdObj
 }
 
@@ -92,6 +102,9 @@ type CmdObj struct {
 
 	// can be set so that we don't run certain commands simultaneously
 	mutex *deadlock.Mutex
+
+	// see WithTimeout()
+	timeout time.Duration
 }
 
 type CredentialStrategy int
@@ -209,6 +222,12 @@ func (self *CmdObj) PromptOnCredentialRequest(task gocui.Task) ICmdObj {
 	return self
 }
 
+func (self *CmdObj) CancelWith(task gocui.Task) ICmdObj {
+	self.task = task
+
+	return self
+}
+
 func (self *CmdObj) FailOnCredentialRequest() ICmdObj {
 	self.credentialStrategy = FAIL
 
@@ -223,6 +242,16 @@ func (self *CmdObj) GetTask() gocui.Task {
 	return self.task
 }
 
+func (self *CmdObj) WithTimeout(timeout time.Duration) ICmdObj {
+	self.timeout = timeout
+
+	return self
+}
+
+func (self *CmdObj) GetTimeout() time.Duration {
+	return self.timeout
+}
+
 func (self *CmdObj) Clone() ICmdObj {
 	clone := &CmdObj{}
 	*clone = *self
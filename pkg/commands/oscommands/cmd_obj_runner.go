@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -34,12 +36,15 @@ func (self *cmdObjRunner) Run(cmdObj ICmdObj) error {
 		defer cmdObj.Mutex().Unlock()
 	}
 
+	// the output isn't needed here, so it doesn't matter if it's incomplete
 	if cmdObj.GetCredentialStrategy() != NONE {
-		return self.runWithCredentialHandling(cmdObj)
+		_, _, err := self.runWithCredentialHandling(cmdObj)
+		return ignoreIncompleteOutput(err)
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		return self.runAndStream(cmdObj)
+		_, _, err := self.runAndStream(cmdObj)
+		return ignoreIncompleteOutput(err)
 	}
 
 	_, err := self.RunWithOutputAux(cmdObj)
@@ -52,20 +57,16 @@ func (self *cmdObjRunner) RunWithOutput(cmdObj ICmdObj) (string, error) {
 		defer cmdObj.Mutex().Unlock()
 	}
 
+	// the output of these is only stdout, because stderr is streamed to the
+	// command log
 	if cmdObj.GetCredentialStrategy() != NONE {
-		err := self.runWithCredentialHandling(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutput
-		// but ignore the output, hence why we've got this check here.
-		return "", err
+		stdout, _, err := self.runWithCredentialHandling(cmdObj)
+		return stdout, err
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		err := self.runAndStream(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutput
-		// but ignore the output, hence why we've got this check here.
-		return "", err
+		stdout, _, err := self.runAndStream(cmdObj)
+		return stdout, err
 	}
 
 	return self.RunWithOutputAux(cmdObj)
@@ -78,19 +79,11 @@ func (self *cmdObjRunner) RunWithOutputs(cmdObj ICmdObj) (string, string, error)
 	}
 
 	if cmdObj.GetCredentialStrategy() != NONE {
-		err := self.runWithCredentialHandling(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutputs
-		// but ignore the output, hence why we've got this check here.
-		return "", "", err
+		return self.runWithCredentialHandling(cmdObj)
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		err := self.runAndStream(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutputs
-		// but ignore the output, hence why we've got this check here.
-		return "", "", err
+		return self.runAndStream(cmdObj)
 	}
 
 	return self.RunWithOutputsAux(cmdObj)
@@ -104,7 +97,16 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj ICmdObj) (string, error) {
 	}
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	var rawOutput bytes.Buffer
+	cmd := cmdObj.GetCmd()
+	cmd.Stdout = &rawOutput
+	cmd.Stderr = &rawOutput
+	err := self.runTerminable(cmdObj, cmd)
+	self.logCmdObjResult(cmdObj, t, err)
+	if wasTerminated(err) {
+		return "", err
+	}
+	output, err := sanitisedCommandOutput(rawOutput.Bytes(), err)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
@@ -126,7 +128,11 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj ICmdObj) (string, string, err
 	cmd := cmdObj.GetCmd()
 	cmd.Stdout = &outBuffer
 	cmd.Stderr = &errBuffer
-	err := cmd.Run()
+	err := self.runTerminable(cmdObj, cmd)
+	self.logCmdObjResult(cmdObj, t, err)
+	if wasTerminated(err) {
+		return "", "", err
+	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
@@ -162,14 +168,26 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 
 	scanner := bufio.NewScanner(stdoutPipe)
 	scanner.Split(bufio.ScanLines)
+	if canBeTerminated(cmdObj) {
+		PrepareForChildren(cmd)
+	}
 	if err := cmd.Start(); err != nil {
+		self.logCmdObjResult(cmdObj, t, err)
 		return err
 	}
 
+	stopWatching := func() error { return nil }
+	if canBeTerminated(cmdObj) {
+		stopWatching = self.terminateOnCancelOrTimeout(cmdObj, cmd)
+	}
+
 	for scanner.Scan() {
 		line := scanner.Text()
 		stop, err := onLine(line)
 		if err != nil {
+			_ = Kill(cmd)
+			_ = cmd.Wait()
+			_ = stopWatching()
 			return err
 		}
 		if stop {
@@ -178,7 +196,14 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		}
 	}
 
-	_ = cmd.Wait()
+	err = cmd.Wait()
+	if killedErr := stopWatching(); killedErr != nil {
+		err = killedErr
+	}
+	self.logCmdObjResult(cmdObj, t, err)
+	if wasTerminated(err) {
+		return err
+	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
@@ -189,6 +214,27 @@ func (self *cmdObjRunner) logCmdObj(cmdObj ICmdObj) {
 	self.guiIO.logCommandFn(cmdObj.ToString(), true)
 }
 
+func (self *cmdObjRunner) logCmdObjResult(cmdObj ICmdObj, start time.Time, err error) {
+	if cmdObj.ShouldLog() {
+		self.guiIO.logCommandResultFn(cmdObj.ToString(), ExitCode(err), time.Since(start))
+	}
+}
+
+// Returns the exit code of a command given the error it returned, or -1 if it
+// couldn't be started or was killed by a signal
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
+
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
 	outputString := string(output)
 	if err != nil {
@@ -208,7 +254,7 @@ type cmdHandler struct {
 	close      func() error
 }
 
-func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj) error {
+func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj) (string, string, error) {
 	return self.runAndStreamAux(cmdObj, func(handler *cmdHandler, cmdWriter io.Writer) {
 		go func() {
 			_, _ = io.Copy(cmdWriter, handler.stdoutPipe)
@@ -219,7 +265,7 @@ func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj) error {
 func (self *cmdObjRunner) runAndStreamAux(
 	cmdObj ICmdObj,
 	onRun func(*cmdHandler, io.Writer),
-) error {
+) (string, string, error) {
 	// if we're streaming this we don't want any fancy terminal stuff
 	cmdObj.AddEnvVars("TERM=dumb")
 
@@ -232,15 +278,24 @@ func (self *cmdObjRunner) runAndStreamAux(
 	cmd := cmdObj.GetCmd()
 
 	var stderr bytes.Buffer
-	cmd.Stderr = io.MultiWriter(cmdWriter, &stderr)
+	var stderrWriter io.Writer = &stderr
+	// progress lines are shown in the command log, but don't belong in the
+	// command's error
+	var progressWriter *progressWriter
+	if reporter, ok := cmdObj.GetTask().(ProgressReporter); ok {
+		progressWriter = newProgressWriter(reporter, &stderr)
+		stderrWriter = progressWriter
+	}
+	cmd.Stderr = io.MultiWriter(cmdWriter, stderrWriter)
 
 	handler, err := self.getCmdHandler(cmd)
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
 	var stdout bytes.Buffer
-	handler.stdoutPipe = io.TeeReader(handler.stdoutPipe, &stdout)
+	stdoutRead := make(chan struct{})
+	handler.stdoutPipe = &notifyingReader{reader: io.TeeReader(handler.stdoutPipe, &stdout), done: stdoutRead}
 
 	defer func() {
 		if closeErr := handler.close(); closeErr != nil {
@@ -250,29 +305,79 @@ func (self *cmdObjRunner) runAndStreamAux(
 
 	t := time.Now()
 
+	stopWatching := self.terminateOnCancelOrTimeout(cmdObj, cmd)
+
 	onRun(handler, cmdWriter)
 
 	err = cmd.Wait()
+	self.logCmdObjResult(cmdObj, t, err)
+
+	if killedErr := stopWatching(); killedErr != nil {
+		return "", "", killedErr
+	}
+
+	if progressWriter != nil {
+		progressWriter.Flush()
+	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	// The output may not have been read completely when the command exits. We
+	// don't wait for it forever, because a process started by the command can
+	// keep the pty open, but we don't pretend that what we got is all of it.
+	stdoutStr := ""
+	var stdoutErr error
+	select {
+	case <-stdoutRead:
+		// a pty uses \r\n line endings
+		stdoutStr = strings.ReplaceAll(stdout.String(), "\r\n", "\n")
+	case <-time.After(stdoutReadTimeout):
+		self.log.Warnf("Output of %s wasn't read completely", cmdObj.ToString())
+		stdoutErr = errIncompleteOutput
+	}
+
+	stderrStr := stderr.String()
 	if err != nil {
-		errStr := stderr.String()
-		if errStr != "" {
-			return errors.New(errStr)
+		if stderrStr != "" {
+			return stdoutStr, stderrStr, errors.New(stderrStr)
 		}
 
 		if cmdObj.ShouldIgnoreEmptyError() {
-			return nil
+			return stdoutStr, stderrStr, nil
 		}
-		stdoutStr := stdout.String()
 		if stdoutStr != "" {
-			return errors.New(stdoutStr)
+			return stdoutStr, stderrStr, errors.New(stdoutStr)
 		}
-		return errors.New("Command exited with non-zero exit code, but no output")
+		return "", "", errors.New("Command exited with non-zero exit code, but no output")
 	}
 
-	return nil
+	return stdoutStr, stderrStr, stdoutErr
+}
+
+const stdoutReadTimeout = 1 * time.Second
+
+var errIncompleteOutput = errors.New("The output of the command couldn't be read completely")
+
+func ignoreIncompleteOutput(err error) error {
+	if errors.Is(err, errIncompleteOutput) {
+		return nil
+	}
+	return err
+}
+
+// Closes done once reading fails, which happens at the end of the output
+type notifyingReader struct {
+	reader io.Reader
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (self *notifyingReader) Read(p []byte) (int, error) {
+	n, err := self.reader.Read(p)
+	if err != nil {
+		self.once.Do(func() { close(self.done) })
+	}
+	return n, err
 }
 
 type CredentialType int
@@ -294,10 +399,10 @@ var failPromptFn = func(CredentialType) <-chan string {
 	return ch
 }
 
-func (self *cmdObjRunner) runWithCredentialHandling(cmdObj ICmdObj) error {
+func (self *cmdObjRunner) runWithCredentialHandling(cmdObj ICmdObj) (string, string, error) {
 	promptFn, err := self.getCredentialPromptFn(cmdObj)
 	if err != nil {
-		return err
+		return "", "", err
 	}
 
 	return self.runAndDetectCredentialRequest(cmdObj, promptFn)
@@ -321,7 +426,7 @@ func (self *cmdObjRunner) getCredentialPromptFn(cmdObj ICmdObj) (func(Credential
 func (self *cmdObjRunner) runAndDetectCredentialRequest(
 	cmdObj ICmdObj,
 	promptUserForCredential func(CredentialType) <-chan string,
-) error {
+) (string, string, error) {
 	// setting the output to english so we can parse it for a username/password request
 	cmdObj.AddEnvVars("LANG=en_US.UTF-8", "LC_ALL=en_US.UTF-8")
 
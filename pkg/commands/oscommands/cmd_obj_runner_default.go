@@ -5,6 +5,8 @@ package oscommands
 
 import (
 	"os/exec"
+	"syscall"
+	"time"
 
 	"github.com/creack/pty"
 )
@@ -23,3 +25,24 @@ func (self *cmdObjRunner) getCmdHandler(cmd *exec.Cmd) (*cmdHandler, error) {
 		close:      ptmx.Close,
 	}, nil
 }
+
+// pty.Start runs the command in a new session, so it leads a process group of
+// its own which also contains the processes it starts, e.g. ssh. Git removes
+// its lock files when it's terminated, so we give it a chance to do that
+// before killing the group.
+func (self *cmdObjRunner) terminateCmd(cmd *exec.Cmd, exited <-chan struct{}) error {
+	if cmd.Process == nil {
+		return nil
+	}
+
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM); err != nil {
+		return err
+	}
+
+	select {
+	case <-exited:
+		return nil
+	case <-time.After(terminateTimeout):
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
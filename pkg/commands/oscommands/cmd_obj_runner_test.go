@@ -3,9 +3,11 @@ package oscommands
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
 )
 
 func getRunner() *cmdObjRunner {
@@ -121,3 +123,58 @@ func TestProcessOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestLogCommandResult(t *testing.T) {
+	type result struct {
+		cmdStr   string
+		exitCode int
+	}
+
+	scenarios := []struct {
+		name     string
+		args     []string
+		dontLog  bool
+		expected []result
+	}{
+		{
+			name:     "successful command",
+			args:     []string{"git", "--version"},
+			expected: []result{{cmdStr: "git --version", exitCode: 0}},
+		},
+		{
+			name:     "failing command",
+			args:     []string{"git", "not-a-command"},
+			expected: []result{{cmdStr: "git not-a-command", exitCode: 1}},
+		},
+		{
+			name:     "command that can't be started",
+			args:     []string{"not-a-program-that-exists"},
+			expected: []result{{cmdStr: "not-a-program-that-exists", exitCode: -1}},
+		},
+		{
+			name:     "command that isn't logged",
+			args:     []string{"git", "--version"},
+			dontLog:  true,
+			expected: []result{},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			results := []result{}
+			runner := getRunner()
+			runner.guiIO.logCommandResultFn = func(cmdStr string, exitCode int, duration time.Duration) {
+				results = append(results, result{cmdStr: cmdStr, exitCode: exitCode})
+			}
+
+			cmdObj := NewDummyCmdObjBuilder(runner).New(s.args)
+			if s.dontLog {
+				cmdObj.DontLog()
+			}
+			_ = cmdObj.Run()
+
+			assert.Equal(t, s.expected, results)
+		})
+	}
+}
@@ -49,3 +49,7 @@ func (self *cmdObjRunner) getCmdHandler(cmd *exec.Cmd) (*cmdHandler, error) {
 		close:      func() error { return nil },
 	}, nil
 }
+
+func (self *cmdObjRunner) terminateCmd(cmd *exec.Cmd, exited <-chan struct{}) error {
+	return Kill(cmd)
+}
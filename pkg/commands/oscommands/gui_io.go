@@ -2,6 +2,7 @@ package oscommands
 
 import (
 	"io"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -19,6 +20,9 @@ type guiIO struct {
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
 	logCommandFn func(str string, isCommandLineCommand bool)
+	// this is called after a command that was logged with logCommandFn has
+	// finished, so that its outcome can be recorded in the audit log.
+	logCommandResultFn func(str string, exitCode int, duration time.Duration)
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
@@ -32,12 +36,14 @@ type guiIO struct {
 func NewGuiIO(
 	log *logrus.Entry,
 	logCommandFn func(string, bool),
+	logCommandResultFn func(string, int, time.Duration),
 	newCmdWriterFn func() io.Writer,
 	promptForCredentialFn func(CredentialType) <-chan string,
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		logCommandResultFn:    logCommandResultFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
 	}
@@ -49,6 +55,7 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          func(string, bool) {},
+		logCommandResultFn:    func(string, int, time.Duration) {},
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
 	}
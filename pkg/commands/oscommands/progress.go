@@ -0,0 +1,104 @@
+package oscommands
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+)
+
+// The progress of a long-running git command like fetch or push, which git
+// writes to stderr when it's passed --progress
+type Progress struct {
+	// What the progress is for if there are several commands running at once,
+	// e.g. the name of the remote being fetched
+	Label string
+	// E.g. 'Receiving objects'
+	Phase   string
+	Percent int
+	Done    int
+	Total   int
+}
+
+// E.g. 'origin: Receiving objects 45% (450/1000)'
+func (self Progress) String() string {
+	str := fmt.Sprintf("%s %d%% (%d/%d)", self.Phase, self.Percent, self.Done, self.Total)
+	if self.Label != "" {
+		return self.Label + ": " + str
+	}
+	return str
+}
+
+// Implemented by tasks that can show the progress of the commands run with
+// them. If a command's task implements it, the progress lines are taken out
+// of the command's stderr and passed to the task instead.
+type ProgressReporter interface {
+	ReportProgress(progress Progress)
+}
+
+// Returns true if the task of a command wants its progress, in which case the
+// command should be run with --progress
+func WantsProgress(task gocui.Task) bool {
+	_, ok := task.(ProgressReporter)
+	return ok
+}
+
+// e.g. 'Receiving objects:  45% (450/1000), 1.20 MiB | 2.00 MiB/s' or
+// 'remote: Compressing objects: 100% (3/3), done.'
+var progressRegex = regexp.MustCompile(`^(?:remote: )?([A-Za-z][A-Za-z ]*):\s+(\d+)% \((\d+)/(\d+)\)`)
+
+func ParseProgress(line string) (Progress, bool) {
+	match := progressRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return Progress{}, false
+	}
+
+	percent, _ := strconv.Atoi(match[2])
+	done, _ := strconv.Atoi(match[3])
+	total, _ := strconv.Atoi(match[4])
+	return Progress{Phase: match[1], Percent: percent, Done: done, Total: total}, true
+}
+
+// Git updates a progress line by writing it again after a '\r', so the output
+// is split at both '\r' and '\n'. Progress lines are reported, and everything
+// else is written to writer.
+type progressWriter struct {
+	reporter ProgressReporter
+	writer   io.Writer
+	line     []byte
+}
+
+func newProgressWriter(reporter ProgressReporter, writer io.Writer) *progressWriter {
+	return &progressWriter{reporter: reporter, writer: writer}
+}
+
+func (self *progressWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		self.line = append(self.line, b)
+		if b == '\r' || b == '\n' {
+			self.Flush()
+		}
+	}
+
+	return len(p), nil
+}
+
+// Handles the rest of the output if it didn't end with a newline
+func (self *progressWriter) Flush() {
+	line := self.line
+	self.line = nil
+
+	if strings.TrimSpace(string(line)) == "" {
+		return
+	}
+
+	if progress, ok := ParseProgress(string(line)); ok {
+		self.reporter.ReportProgress(progress)
+		return
+	}
+
+	_, _ = self.writer.Write(line)
+}
@@ -0,0 +1,92 @@
+package oscommands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProgress(t *testing.T) {
+	scenarios := []struct {
+		line     string
+		expected Progress
+		ok       bool
+	}{
+		{
+			line:     "Receiving objects:  45% (450/1000), 1.20 MiB | 2.00 MiB/s",
+			expected: Progress{Phase: "Receiving objects", Percent: 45, Done: 450, Total: 1000},
+			ok:       true,
+		},
+		{
+			line:     "remote: Compressing objects: 100% (3/3), done.",
+			expected: Progress{Phase: "Compressing objects", Percent: 100, Done: 3, Total: 3},
+			ok:       true,
+		},
+		{
+			line:     "Writing objects:   0% (0/12)\r",
+			expected: Progress{Phase: "Writing objects", Percent: 0, Done: 0, Total: 12},
+			ok:       true,
+		},
+		{
+			line: "remote: Enumerating objects: 5, done.",
+			ok:   false,
+		},
+		{
+			line: "fatal: 'origin' does not appear to be a git repository",
+			ok:   false,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.line, func(t *testing.T) {
+			progress, ok := ParseProgress(s.line)
+			assert.Equal(t, s.ok, ok)
+			assert.Equal(t, s.expected, progress)
+		})
+	}
+}
+
+func TestProgressString(t *testing.T) {
+	progress := Progress{Phase: "Receiving objects", Percent: 45, Done: 450, Total: 1000}
+	assert.Equal(t, "Receiving objects 45% (450/1000)", progress.String())
+
+	progress.Label = "origin"
+	assert.Equal(t, "origin: Receiving objects 45% (450/1000)", progress.String())
+}
+
+type fakeProgressReporter struct {
+	reported []Progress
+}
+
+func (self *fakeProgressReporter) ReportProgress(progress Progress) {
+	self.reported = append(self.reported, progress)
+}
+
+func TestProgressWriter(t *testing.T) {
+	reporter := &fakeProgressReporter{}
+	var output bytes.Buffer
+	writer := newProgressWriter(reporter, &output)
+
+	// written in chunks that don't line up with the lines
+	for _, chunk := range []string{
+		"From ../origin\nReceiving obj",
+		"ects:  50% (1/2)\rReceiving objects: 100% (2/2), done.\n",
+		" * [new branch]      feature    -> origin/feature\n",
+		"error: no newline at the end",
+	} {
+		_, err := writer.Write([]byte(chunk))
+		assert.NoError(t, err)
+	}
+	writer.Flush()
+
+	assert.Equal(t, []Progress{
+		{Phase: "Receiving objects", Percent: 50, Done: 1, Total: 2},
+		{Phase: "Receiving objects", Percent: 100, Done: 2, Total: 2},
+	}, reporter.reported)
+	assert.Equal(t,
+		"From ../origin\n * [new branch]      feature    -> origin/feature\nerror: no newline at the end",
+		output.String(),
+	)
+}
@@ -0,0 +1,65 @@
+package patch
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Renders the lines of a diff one at a time. Rows can be held back until a
+// later line shows how they're to be rendered.
+type diffRenderer interface {
+	Render(line string) []string
+	Flush() []string
+}
+
+// Reads a unified diff from the given reader, and renders it line by line
+type diffReader struct {
+	reader   *bufio.Reader
+	renderer diffRenderer
+	buffer   bytes.Buffer
+	err      error
+}
+
+// Renders the diff side by side
+func NewSideBySideReader(reader io.Reader, width int, wordDiff WordDiffMode) io.Reader {
+	return &diffReader{
+		reader:   bufio.NewReader(reader),
+		renderer: NewSideBySideRenderer(width, wordDiff),
+	}
+}
+
+// Renders the diff with the changes within lines highlighted
+func NewWordDiffReader(reader io.Reader, wordDiff WordDiffMode) io.Reader {
+	return &diffReader{
+		reader:   bufio.NewReader(reader),
+		renderer: NewWordDiffRenderer(wordDiff),
+	}
+}
+
+func (self *diffReader) Read(p []byte) (int, error) {
+	for self.buffer.Len() == 0 {
+		if self.err != nil {
+			return 0, self.err
+		}
+
+		line, err := self.reader.ReadString('\n')
+		if line != "" {
+			self.writeRows(self.renderer.Render(strings.TrimSuffix(line, "\n")))
+		}
+		if err != nil {
+			self.writeRows(self.renderer.Flush())
+			self.err = err
+		}
+	}
+
+	return self.buffer.Read(p)
+}
+
+func (self *diffReader) writeRows(rows []string) {
+	for _, row := range rows {
+		self.buffer.WriteString(row)
+		self.buffer.WriteString("\n")
+	}
+}
@@ -21,6 +21,8 @@ type patchPresenter struct {
 	lastLineIndex int
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+	// how changes within lines are highlighted
+	wordDiff WordDiffMode
 }
 
 // formats the patch as a plain string
@@ -51,6 +53,8 @@ type FormatViewOpts struct {
 	LastLineIndex int
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// how changes within lines are highlighted
+	WordDiff WordDiffMode
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -67,6 +71,7 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 		firstLineIndex: opts.FirstLineIndex,
 		lastLineIndex:  opts.LastLineIndex,
 		incLineIndices: includedLineIndices,
+		wordDiff:       opts.WordDiff,
 	}
 	return presenter.format()
 }
@@ -85,26 +90,28 @@ func (self *patchPresenter) format() string {
 
 		lineIdx++
 	}
-	appendFormattedLine := func(line string, style style.TextStyle) {
+	appendFormattedLine := func(line string, style style.TextStyle, changedRanges []lineRange) {
 		formattedLine := self.formatLine(
 			line,
 			style,
 			lineIdx,
+			changedRanges,
 		)
 
 		appendLine(formattedLine)
 	}
 
 	for _, line := range self.patch.header {
-		appendFormattedLine(line, theme.DefaultTextColor.SetBold())
+		appendFormattedLine(line, theme.DiffHeaderColor, nil)
 	}
 
 	for _, hunk := range self.patch.hunks {
 		appendLine(
 			self.formatLine(
 				hunk.formatHeaderStart(),
-				style.FgCyan,
+				theme.DiffHunkHeaderColor,
 				lineIdx,
+				nil,
 			) +
 				// we're splitting the line into two parts: the diff header and the context
 				// We explicitly pass 'included' as false here so that we're only tagging the
@@ -114,11 +121,16 @@ func (self *patchPresenter) format() string {
 					theme.DefaultTextColor,
 					lineIdx,
 					false,
+					nil,
 				),
 		)
 
-		for _, line := range hunk.bodyLines {
-			appendFormattedLine(line.Content, self.patchLineStyle(line))
+		changedRanges := map[int][]lineRange{}
+		if !self.plain {
+			changedRanges = wordDiffRanges(hunk.bodyLines, self.wordDiff)
+		}
+		for i, line := range hunk.bodyLines {
+			appendFormattedLine(line.Content, self.patchLineStyle(line), changedRanges[i])
 		}
 	}
 
@@ -128,24 +140,26 @@ func (self *patchPresenter) format() string {
 func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle {
 	switch patchLine.Kind {
 	case ADDITION:
-		return style.FgGreen
+		return theme.DiffAddedLineColor
 	case DELETION:
-		return style.FgRed
+		return theme.DiffRemovedLineColor
 	default:
 		return theme.DefaultTextColor
 	}
 }
 
-func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int) string {
+func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int, changedRanges []lineRange) string {
 	included := self.incLineIndices.Includes(index)
 
-	return self.formatLineAux(str, textStyle, index, included)
+	return self.formatLineAux(str, textStyle, index, included, changedRanges)
 }
 
 // 'selected' means you've got it highlighted with your cursor
 // 'included' means the line has been included in the patch (only applicable when
 // building a patch)
-func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle, index int, included bool) string {
+// 'changedRanges' are the parts of the line that changed within the line, which
+// are shown in reverse video
+func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle, index int, included bool, changedRanges []lineRange) string {
 	if self.plain {
 		return str
 	}
@@ -157,7 +171,16 @@ func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle,
 	}
 
 	firstCharStyle := textStyle
-	if included {
+	if theme.AccessibleMode {
+		// make the +/- stand out, and mark included lines in a way that
+		// doesn't depend on telling green apart
+		if strings.HasPrefix(str, "+") || strings.HasPrefix(str, "-") {
+			firstCharStyle = firstCharStyle.SetBold()
+		}
+		if included {
+			firstCharStyle = firstCharStyle.SetReverse()
+		}
+	} else if included {
 		firstCharStyle = firstCharStyle.MergeStyle(style.BgGreen)
 	}
 
@@ -165,5 +188,22 @@ func (self *patchPresenter) formatLineAux(str string, textStyle style.TextStyle,
 		return firstCharStyle.Sprint(str)
 	}
 
-	return firstCharStyle.Sprint(str[:1]) + textStyle.Sprint(str[1:])
+	if len(changedRanges) == 0 {
+		return firstCharStyle.Sprint(str[:1]) + textStyle.Sprint(str[1:])
+	}
+
+	result := firstCharStyle.Sprint(str[:1])
+	changedStyle := textStyle.SetReverse()
+	offset := 1
+	for _, changedRange := range changedRanges {
+		if changedRange.start > offset {
+			result += textStyle.Sprint(str[offset:changedRange.start])
+		}
+		result += changedStyle.Sprint(str[changedRange.start:changedRange.end])
+		offset = changedRange.end
+	}
+	if offset < len(str) {
+		result += textStyle.Sprint(str[offset:])
+	}
+	return result
 }
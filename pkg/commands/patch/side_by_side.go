@@ -0,0 +1,318 @@
+package patch
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+)
+
+// Below this width there isn't enough room for two halves, so diffs are shown
+// as they are
+const minSideBySideWidth = 40
+
+// The view expands tabs to this width too
+const sideBySideTabWidth = 4
+
+var sideBySideHunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// Not using utils.Decolorise because it caches every string it sees, and the
+// output of a diff can be big
+var ansiEscapeRegexp = regexp.MustCompile(`\x1B\[([0-9]{1,3}(;[0-9]{1,3})*)?[mGK]`)
+
+// Renders a unified diff with the old version of each hunk on the left and the
+// new version on the right. Everything outside of hunks, like commit messages
+// and file headers, is passed through as it is. Each half is cut off at the
+// width that's available to it, so that every row is one line of the view and
+// the two halves always scroll together.
+type SideBySideRenderer struct {
+	width    int
+	wordDiff WordDiffMode
+
+	// the index of the next line of the unified diff
+	lineIdx int
+	// the numbers of the lines of the current hunk that are still to come
+	oldRemaining int
+	newRemaining int
+	// the line numbers of the next old and new line
+	oldLineNumber int
+	newLineNumber int
+	// the width of the line numbers of the current hunk
+	numberWidth int
+	// removed and added lines that haven't been paired up with each other yet
+	pending []*pendingLine
+}
+
+type pendingLine struct {
+	line    *PatchLine
+	lineIdx int
+}
+
+// A row of the side-by-side diff, and the index of the line of the unified
+// diff that it stands for. A row that shows a removed and an added line stands
+// for the removed one on its left half and for the added one on its right half.
+type sideBySideRow struct {
+	content      string
+	lineIdx      int
+	rightLineIdx int
+}
+
+func NewSideBySideRenderer(width int, wordDiff WordDiffMode) *SideBySideRenderer {
+	return &SideBySideRenderer{
+		width:    width,
+		wordDiff: wordDiff,
+	}
+}
+
+// Takes the next line of the diff, which may be coloured, and returns the rows
+// that are ready to be shown. Removed and added lines are held back until it's
+// clear which lines they're shown next to.
+func (self *SideBySideRenderer) Render(line string) []string {
+	return rowContents(self.render(line))
+}
+
+// Returns the rows that were held back, once the diff has ended
+func (self *SideBySideRenderer) Flush() []string {
+	return rowContents(self.flush())
+}
+
+func rowContents(rows []sideBySideRow) []string {
+	contents := make([]string, 0, len(rows))
+	for _, row := range rows {
+		contents = append(contents, row.content)
+	}
+	return contents
+}
+
+func (self *SideBySideRenderer) render(line string) []sideBySideRow {
+	lineIdx := self.lineIdx
+	self.lineIdx++
+
+	if self.width < minSideBySideWidth {
+		return []sideBySideRow{{content: line, lineIdx: lineIdx, rightLineIdx: lineIdx}}
+	}
+
+	plainLine := ansiEscapeRegexp.ReplaceAllString(line, "")
+
+	if self.oldRemaining <= 0 && self.newRemaining <= 0 {
+		rows := self.flush()
+		self.startHunk(plainLine)
+		return append(rows, sideBySideRow{content: line, lineIdx: lineIdx, rightLineIdx: lineIdx})
+	}
+
+	patchLine := newHunkLine(plainLine)
+	switch patchLine.Kind {
+	case DELETION:
+		self.oldRemaining--
+		self.pending = append(self.pending, &pendingLine{line: patchLine, lineIdx: lineIdx})
+		return nil
+	case ADDITION:
+		self.newRemaining--
+		self.pending = append(self.pending, &pendingLine{line: patchLine, lineIdx: lineIdx})
+		return nil
+	case NEWLINE_MESSAGE:
+		rows := self.flush()
+		return append(rows, sideBySideRow{content: line, lineIdx: lineIdx, rightLineIdx: lineIdx})
+	default:
+		rows := self.flush()
+		self.oldRemaining--
+		self.newRemaining--
+		content := self.formatHalf(&self.oldLineNumber, patchLine.Content, theme.DefaultTextColor, nil, self.leftWidth()) +
+			self.separator() +
+			self.formatHalf(&self.newLineNumber, patchLine.Content, theme.DefaultTextColor, nil, self.rightWidth())
+		return append(rows, sideBySideRow{content: content, lineIdx: lineIdx, rightLineIdx: lineIdx})
+	}
+}
+
+// If the line is a hunk header, sets things up for the lines of the hunk
+func (self *SideBySideRenderer) startHunk(plainLine string) {
+	match := sideBySideHunkHeaderRegexp.FindStringSubmatch(plainLine)
+	if match == nil {
+		return
+	}
+
+	self.oldLineNumber = utils.MustConvertToInt(match[1])
+	self.oldRemaining = hunkLineCount(match[2])
+	self.newLineNumber = utils.MustConvertToInt(match[3])
+	self.newRemaining = hunkLineCount(match[4])
+	self.numberWidth = len(strconv.Itoa(utils.Max(
+		self.oldLineNumber+self.oldRemaining,
+		self.newLineNumber+self.newRemaining,
+	)))
+}
+
+// The count is left out of hunk headers if it's 1
+func hunkLineCount(count string) int {
+	if count == "" {
+		return 1
+	}
+	return utils.MustConvertToInt(count)
+}
+
+// Shows the held back removed lines next to the held back added lines, the
+// first removed line next to the first added line and so on
+func (self *SideBySideRenderer) flush() []sideBySideRow {
+	if len(self.pending) == 0 {
+		return nil
+	}
+
+	lines := make([]*PatchLine, len(self.pending))
+	for i, pending := range self.pending {
+		lines[i] = pending.line
+	}
+	changedRanges := wordDiffRanges(lines, self.wordDiff)
+
+	deletions := []int{}
+	additions := []int{}
+	for i, line := range lines {
+		if line.Kind == DELETION {
+			deletions = append(deletions, i)
+		} else {
+			additions = append(additions, i)
+		}
+	}
+
+	rows := []sideBySideRow{}
+	for i := 0; i < len(deletions) || i < len(additions); i++ {
+		var left, right string
+		var lineIdx, rightLineIdx int
+
+		if i < len(deletions) {
+			idx := deletions[i]
+			left = self.formatHalf(&self.oldLineNumber, lines[idx].Content, style.FgRed, changedRanges[idx], self.leftWidth())
+			lineIdx = self.pending[idx].lineIdx
+			rightLineIdx = lineIdx
+		} else {
+			left = strings.Repeat(" ", self.leftWidth())
+		}
+
+		if i < len(additions) {
+			idx := additions[i]
+			right = self.formatHalf(&self.newLineNumber, lines[idx].Content, style.FgGreen, changedRanges[idx], self.rightWidth())
+			rightLineIdx = self.pending[idx].lineIdx
+			if i >= len(deletions) {
+				lineIdx = rightLineIdx
+			}
+		}
+
+		rows = append(rows, sideBySideRow{content: left + self.separator() + right, lineIdx: lineIdx, rightLineIdx: rightLineIdx})
+	}
+
+	self.pending = nil
+	return rows
+}
+
+func (self *SideBySideRenderer) leftWidth() int {
+	return (self.width - 1) / 2
+}
+
+func (self *SideBySideRenderer) rightWidth() int {
+	return self.width - 1 - self.leftWidth()
+}
+
+func (self *SideBySideRenderer) separator() string {
+	return style.FgBlue.Sprint("│")
+}
+
+// Formats one half of a row: the line number, followed by the line, cut off
+// or padded to the given width. Advances the line number.
+func (self *SideBySideRenderer) formatHalf(lineNumber *int, content string, textStyle style.TextStyle, changedRanges []lineRange, width int) string {
+	number := fmt.Sprintf("%*d ", self.numberWidth, *lineNumber)
+	*lineNumber++
+
+	firstCharStyle := textStyle
+	if theme.AccessibleMode && (strings.HasPrefix(content, "+") || strings.HasPrefix(content, "-")) {
+		firstCharStyle = firstCharStyle.SetBold()
+	}
+	changedStyle := textStyle.SetReverse()
+
+	result := &strings.Builder{}
+	result.WriteString(number)
+	column := 0
+	available := width - len(number)
+
+	offset := 0
+	segment := func(end int, segmentStyle style.TextStyle) bool {
+		text, fits := expandAndCut(content[offset:end], &column, available)
+		result.WriteString(segmentStyle.Sprint(text))
+		offset = end
+		return fits
+	}
+
+	fits := len(content) == 0 || segment(1, firstCharStyle)
+	for _, changedRange := range changedRanges {
+		if !fits {
+			break
+		}
+		if changedRange.start > offset {
+			fits = segment(changedRange.start, textStyle)
+		}
+		if fits {
+			fits = segment(changedRange.end, changedStyle)
+		}
+	}
+	if fits && offset < len(content) {
+		segment(len(content), textStyle)
+	}
+
+	result.WriteString(strings.Repeat(" ", utils.Max(available-column, 0)))
+	return result.String()
+}
+
+// Expands the tabs in text, starting at the given column, and cuts it off at
+// the available width. Returns false if it was cut off.
+func expandAndCut(text string, column *int, available int) (string, bool) {
+	result := &strings.Builder{}
+	for _, r := range text {
+		str := string(r)
+		if r == '\t' {
+			str = strings.Repeat(" ", sideBySideTabWidth-*column%sideBySideTabWidth)
+		}
+
+		width := runewidth.StringWidth(str)
+		if *column+width > available {
+			return result.String(), false
+		}
+
+		result.WriteString(str)
+		*column += width
+	}
+	return result.String(), true
+}
+
+// Returns the index of the line of a unified diff that was clicked at the
+// given row and column of its side-by-side rendering
+func SideBySideLineIdx(diff string, width int, row int, column int) int {
+	renderer := NewSideBySideRenderer(width, WordDiffOff)
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+
+	lineIdx := func(renderedRow sideBySideRow) int {
+		if column > renderer.leftWidth() {
+			return renderedRow.rightLineIdx
+		}
+		return renderedRow.lineIdx
+	}
+
+	rowIdx := 0
+	for _, line := range lines {
+		for _, renderedRow := range renderer.render(line) {
+			if rowIdx == row {
+				return lineIdx(renderedRow)
+			}
+			rowIdx++
+		}
+	}
+	for _, renderedRow := range renderer.flush() {
+		if rowIdx == row {
+			return lineIdx(renderedRow)
+		}
+		rowIdx++
+	}
+
+	return row
+}
@@ -0,0 +1,116 @@
+package patch
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const sideBySideDiff = `diff --git a/file b/file
+--- a/file
++++ b/file
+@@ -1,3 +1,4 @@
+ first
+-old line
++new line
++added
+ last
+`
+
+func TestSideBySideReader(t *testing.T) {
+	type scenario struct {
+		testName string
+		diff     string
+		width    int
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "removed lines next to added lines",
+			diff:     sideBySideDiff,
+			width:    41,
+			expected: []string{
+				"diff --git a/file b/file",
+				"--- a/file",
+				"+++ b/file",
+				"@@ -1,3 +1,4 @@",
+				"1  first            │1  first            ",
+				"2 -old line         │2 +new line         ",
+				"                    │3 +added            ",
+				"3  last             │4  last             ",
+			},
+		},
+		{
+			testName: "long lines are cut off and tabs expanded",
+			diff:     "@@ -9 +9,2 @@\n-\tsome rather long line\n+\tsome rather long line!\n+x\n",
+			width:    41,
+			expected: []string{
+				"@@ -9 +9,2 @@",
+				" 9 -   some rather l│ 9 +   some rather l",
+				"                    │10 +x               ",
+			},
+		},
+		{
+			testName: "lines outside of hunks are passed through",
+			diff:     "commit 123\n\n    -not a removed line\n@@ -1 +1 @@\n-a\n+b\n\\ No newline at end of file\ndiff --git a/other b/other\n",
+			width:    41,
+			expected: []string{
+				"commit 123",
+				"",
+				"    -not a removed line",
+				"@@ -1 +1 @@",
+				"1 -a                │1 +b                ",
+				"\\ No newline at end of file",
+				"diff --git a/other b/other",
+			},
+		},
+		{
+			testName: "too narrow",
+			diff:     sideBySideDiff,
+			width:    39,
+			expected: strings.Split(strings.TrimSuffix(sideBySideDiff, "\n"), "\n"),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			output, err := io.ReadAll(NewSideBySideReader(strings.NewReader(s.diff), s.width, WordDiffOff))
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, strings.Split(strings.TrimSuffix(utils.Decolorise(string(output)), "\n"), "\n"))
+		})
+	}
+}
+
+func TestSideBySideRendererWordDiff(t *testing.T) {
+	renderer := NewSideBySideRenderer(41, WordDiffWords)
+	rows := []string{}
+	for _, line := range []string{"@@ -1 +1 @@", "-foo bar", "+foo baz"} {
+		rows = append(rows, renderer.Render(line)...)
+	}
+	rows = append(rows, renderer.Flush()...)
+
+	assert.Equal(t, 2, len(rows))
+	assert.Contains(t, rows[1], "\x1b[31;7mbar\x1b[0m")
+	assert.Contains(t, rows[1], "\x1b[32;7mbaz\x1b[0m")
+}
+
+func TestSideBySideLineIdx(t *testing.T) {
+	// clicking the left half
+	for row, expected := range []int{0, 1, 2, 3, 4, 5, 7, 8, 8} {
+		assert.Equal(t, expected, SideBySideLineIdx(sideBySideDiff, 41, row, 5), "row %d", row)
+	}
+
+	// clicking the right half selects the added line of a row that also shows
+	// a removed one
+	for row, expected := range []int{0, 1, 2, 3, 4, 6, 7, 8, 8} {
+		assert.Equal(t, expected, SideBySideLineIdx(sideBySideDiff, 41, row, 30), "row %d", row)
+	}
+
+	// too narrow to be shown side by side
+	assert.Equal(t, 6, SideBySideLineIdx(sideBySideDiff, 39, 6, 30))
+}
@@ -0,0 +1,176 @@
+package patch
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// How changes within lines are highlighted
+type WordDiffMode string
+
+const (
+	WordDiffOff   WordDiffMode = ""
+	WordDiffWords WordDiffMode = "word"
+	WordDiffChars WordDiffMode = "char"
+)
+
+// Lines with more tokens than this aren't diffed word by word, because the
+// time it takes grows with the product of the lengths of the two lines
+const maxWordDiffTokens = 1000
+
+// A range of bytes within a patch line's content
+type lineRange struct {
+	start int
+	end   int
+}
+
+// Returns the ranges of the lines of a hunk body that changed within the line,
+// by index of the line. A block of removed lines that is directly followed by
+// a block of added lines is compared line by line, i.e. the first removed line
+// with the first added line and so on.
+func wordDiffRanges(lines []*PatchLine, mode WordDiffMode) map[int][]lineRange {
+	result := map[int][]lineRange{}
+	if mode == WordDiffOff {
+		return result
+	}
+
+	for i := 0; i < len(lines); {
+		deletionsStart := i
+		for i < len(lines) && lines[i].Kind == DELETION {
+			i++
+		}
+		additionsStart := i
+		for i < len(lines) && lines[i].Kind == ADDITION {
+			i++
+		}
+
+		if deletionsStart == i {
+			i++
+			continue
+		}
+
+		for j := 0; deletionsStart+j < additionsStart && additionsStart+j < i; j++ {
+			deletionIdx, additionIdx := deletionsStart+j, additionsStart+j
+			deleted, added := changedRanges(lines[deletionIdx].Content, lines[additionIdx].Content, mode)
+			if len(deleted) > 0 || len(added) > 0 {
+				result[deletionIdx] = deleted
+				result[additionIdx] = added
+			}
+		}
+	}
+
+	return result
+}
+
+// Compares the content of a removed and an added line without their first
+// character, and returns the ranges in each of them that aren't in the other.
+// Returns nothing if the lines have nothing but whitespace in common, since
+// highlighting everything wouldn't help.
+func changedRanges(before string, after string, mode WordDiffMode) ([]lineRange, []lineRange) {
+	beforeTokens := tokenize(before[1:], mode)
+	afterTokens := tokenize(after[1:], mode)
+	if len(beforeTokens) > maxWordDiffTokens || len(afterTokens) > maxWordDiffTokens {
+		return nil, nil
+	}
+
+	beforeCommon, afterCommon := longestCommonSubsequence(beforeTokens, afterTokens)
+
+	hasCommonText := false
+	for i, common := range beforeCommon {
+		if common && !isWhitespace(beforeTokens[i]) {
+			hasCommonText = true
+			break
+		}
+	}
+	if !hasCommonText {
+		return nil, nil
+	}
+
+	return uncommonRanges(beforeTokens, beforeCommon), uncommonRanges(afterTokens, afterCommon)
+}
+
+// Splits a string into words (runs of letters, digits and underscores), runs
+// of whitespace, and single other characters; or into single characters
+func tokenize(str string, mode WordDiffMode) []string {
+	tokens := []string{}
+	for len(str) > 0 {
+		r, size := utf8.DecodeRuneInString(str)
+		if mode == WordDiffWords {
+			for size < len(str) {
+				next, nextSize := utf8.DecodeRuneInString(str[size:])
+				if !(isWordRune(r) && isWordRune(next)) && !(unicode.IsSpace(r) && unicode.IsSpace(next)) {
+					break
+				}
+				size += nextSize
+			}
+		}
+		tokens = append(tokens, str[:size])
+		str = str[size:]
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isWhitespace(token string) bool {
+	r, _ := utf8.DecodeRuneInString(token)
+	return unicode.IsSpace(r)
+}
+
+// Returns for each token of a and b whether it's part of their longest common
+// subsequence
+func longestCommonSubsequence(a []string, b []string) ([]bool, []bool) {
+	// lengths[i][j] is the length of the LCS of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = utils.Max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	aCommon := make([]bool, len(a))
+	bCommon := make([]bool, len(b))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if a[i] == b[j] {
+			aCommon[i] = true
+			bCommon[j] = true
+			i++
+			j++
+		} else if lengths[i+1][j] >= lengths[i][j+1] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return aCommon, bCommon
+}
+
+// Returns the byte ranges of the tokens that aren't common, merging adjacent
+// ones. The ranges are offset by one to account for the line's first
+// character.
+func uncommonRanges(tokens []string, common []bool) []lineRange {
+	ranges := []lineRange{}
+	offset := 1
+	for i, token := range tokens {
+		if !common[i] {
+			if len(ranges) > 0 && ranges[len(ranges)-1].end == offset {
+				ranges[len(ranges)-1].end += len(token)
+			} else {
+				ranges = append(ranges, lineRange{start: offset, end: offset + len(token)})
+			}
+		}
+		offset += len(token)
+	}
+	return ranges
+}
@@ -0,0 +1,126 @@
+package patch
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+)
+
+// Highlights the changes within the lines of a unified diff, keeping every
+// line of the diff on a row of its own, so that the rows of the view still
+// match the lines of the patch. Lines that didn't change within the line are
+// passed through as they are, with git's colours.
+type WordDiffRenderer struct {
+	wordDiff WordDiffMode
+
+	// the numbers of the lines of the current hunk that are still to come
+	oldRemaining int
+	newRemaining int
+	// removed and added lines that haven't been compared with each other yet,
+	// along with how git coloured them
+	pending         []*PatchLine
+	pendingOriginal []string
+}
+
+func NewWordDiffRenderer(wordDiff WordDiffMode) *WordDiffRenderer {
+	return &WordDiffRenderer{
+		wordDiff: wordDiff,
+	}
+}
+
+// Takes the next line of the diff, which may be coloured, and returns the rows
+// that are ready to be shown. Removed and added lines are held back until it's
+// clear which lines they're compared with.
+func (self *WordDiffRenderer) Render(line string) []string {
+	plainLine := ansiEscapeRegexp.ReplaceAllString(line, "")
+
+	if self.oldRemaining <= 0 && self.newRemaining <= 0 {
+		rows := self.Flush()
+		self.startHunk(plainLine)
+		return append(rows, line)
+	}
+
+	patchLine := newHunkLine(plainLine)
+	switch patchLine.Kind {
+	case DELETION:
+		self.oldRemaining--
+		self.pending = append(self.pending, patchLine)
+		self.pendingOriginal = append(self.pendingOriginal, line)
+		return nil
+	case ADDITION:
+		self.newRemaining--
+		self.pending = append(self.pending, patchLine)
+		self.pendingOriginal = append(self.pendingOriginal, line)
+		return nil
+	case NEWLINE_MESSAGE:
+		return append(self.Flush(), line)
+	default:
+		self.oldRemaining--
+		self.newRemaining--
+		return append(self.Flush(), line)
+	}
+}
+
+// If the line is a hunk header, sets things up for the lines of the hunk
+func (self *WordDiffRenderer) startHunk(plainLine string) {
+	match := sideBySideHunkHeaderRegexp.FindStringSubmatch(plainLine)
+	if match == nil {
+		return
+	}
+
+	self.oldRemaining = hunkLineCount(match[2])
+	self.newRemaining = hunkLineCount(match[4])
+}
+
+// Returns the rows that were held back, with the changes within them
+// highlighted
+func (self *WordDiffRenderer) Flush() []string {
+	if len(self.pending) == 0 {
+		return nil
+	}
+
+	changedRanges := wordDiffRanges(self.pending, self.wordDiff)
+
+	rows := make([]string, len(self.pending))
+	for i, line := range self.pending {
+		if len(changedRanges[i]) == 0 {
+			rows[i] = self.pendingOriginal[i]
+			continue
+		}
+
+		textStyle := style.FgGreen
+		if line.Kind == DELETION {
+			textStyle = style.FgRed
+		}
+		rows[i] = formatChangedRanges(line.Content, textStyle, changedRanges[i])
+	}
+
+	self.pending = nil
+	self.pendingOriginal = nil
+	return rows
+}
+
+// Formats a removed or added line with the given ranges shown in reverse video
+func formatChangedRanges(content string, textStyle style.TextStyle, changedRanges []lineRange) string {
+	firstCharStyle := textStyle
+	if theme.AccessibleMode {
+		firstCharStyle = firstCharStyle.SetBold()
+	}
+	changedStyle := textStyle.SetReverse()
+
+	result := &strings.Builder{}
+	result.WriteString(firstCharStyle.Sprint(content[:1]))
+	offset := 1
+	for _, changedRange := range changedRanges {
+		if changedRange.start > offset {
+			result.WriteString(textStyle.Sprint(content[offset:changedRange.start]))
+		}
+		result.WriteString(changedStyle.Sprint(content[changedRange.start:changedRange.end]))
+		offset = changedRange.end
+	}
+	if offset < len(content) {
+		result.WriteString(textStyle.Sprint(content[offset:]))
+	}
+	return result.String()
+}
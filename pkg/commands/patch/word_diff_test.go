@@ -0,0 +1,136 @@
+package patch
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordDiffRanges(t *testing.T) {
+	type scenario struct {
+		testName string
+		lines    []string
+		mode     WordDiffMode
+		expected map[int][]lineRange
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "off",
+			lines:    []string{"-foo bar", "+foo baz"},
+			mode:     WordDiffOff,
+			expected: map[int][]lineRange{},
+		},
+		{
+			testName: "changed word",
+			lines:    []string{" context", "-foo(bar, 1)", "+foo(baz, 1)", " context"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{
+				1: {{start: 5, end: 8}},
+				2: {{start: 5, end: 8}},
+			},
+		},
+		{
+			testName: "changed characters",
+			lines:    []string{"-foo(bar, 1)", "+foo(baz, 12)"},
+			mode:     WordDiffChars,
+			expected: map[int][]lineRange{
+				0: {{start: 7, end: 8}},
+				1: {{start: 7, end: 8}, {start: 11, end: 12}},
+			},
+		},
+		{
+			testName: "inserted words",
+			lines:    []string{"-a c", "+a b c"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{
+				0: {},
+				1: {{start: 3, end: 5}},
+			},
+		},
+		{
+			testName: "lines are paired in order and extra lines are left alone",
+			lines:    []string{"-one x", "-two x", "+one y", "+two y", "+three y"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{
+				0: {{start: 5, end: 6}},
+				1: {{start: 5, end: 6}},
+				2: {{start: 5, end: 6}},
+				3: {{start: 5, end: 6}},
+			},
+		},
+		{
+			testName: "nothing in common but whitespace",
+			lines:    []string{"-foo bar", "+baz qux"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{},
+		},
+		{
+			testName: "additions that don't follow deletions",
+			lines:    []string{"+foo", " context", "-bar"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{},
+		},
+		{
+			testName: "multi-byte characters",
+			lines:    []string{"-héllo wörld", "+héllo world"},
+			mode:     WordDiffWords,
+			expected: map[int][]lineRange{
+				0: {{start: 8, end: 14}},
+				1: {{start: 8, end: 13}},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			lines := make([]*PatchLine, 0, len(s.lines))
+			for _, line := range s.lines {
+				kind := CONTEXT
+				switch line[0] {
+				case '+':
+					kind = ADDITION
+				case '-':
+					kind = DELETION
+				}
+				lines = append(lines, &PatchLine{Kind: kind, Content: line})
+			}
+
+			assert.Equal(t, s.expected, wordDiffRanges(lines, s.mode))
+		})
+	}
+}
+
+func TestFormatViewWithWordDiff(t *testing.T) {
+	patch := Parse("diff --git a/file b/file\n--- a/file\n+++ b/file\n@@ -1 +1 @@\n-foo bar\n+foo baz\n")
+
+	lines := strings.Split(patch.FormatView(FormatViewOpts{WordDiff: WordDiffWords}), "\n")
+	assert.Equal(t, "\x1b[31m-\x1b[0m\x1b[31mfoo \x1b[0m\x1b[31;7mbar\x1b[0m", lines[4])
+	assert.Equal(t, "\x1b[32m+\x1b[0m\x1b[32mfoo \x1b[0m\x1b[32;7mbaz\x1b[0m", lines[5])
+
+	// the plain patch, which is what gets applied when staging, is unaffected
+	assert.Equal(t, "-foo bar", strings.Split(patch.FormatPlain(), "\n")[4])
+}
+
+func TestWordDiffReader(t *testing.T) {
+	diff := "commit 123\n\n    -not a removed line\n" +
+		"diff --git a/file b/file\n--- a/file\n+++ b/file\n" +
+		"@@ -1,3 +1,4 @@\n first\n-foo bar\n+foo baz\n\x1b[32m+added\x1b[m\n last\n"
+
+	output, err := io.ReadAll(NewWordDiffReader(strings.NewReader(diff), WordDiffWords))
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(output), "\n"), "\n")
+
+	// every line of the diff stays on a line of its own, so that the clicked
+	// line of the view is the line of the patch
+	assert.Equal(t, utils.Decolorise(diff), utils.Decolorise(string(output)))
+	assert.Equal(t, "    -not a removed line", lines[2])
+	assert.Equal(t, "\x1b[31m-\x1b[0m\x1b[31mfoo \x1b[0m\x1b[31;7mbar\x1b[0m", lines[8])
+	assert.Equal(t, "\x1b[32m+\x1b[0m\x1b[32mfoo \x1b[0m\x1b[32;7mbaz\x1b[0m", lines[9])
+	// lines that didn't change within the line keep git's colours
+	assert.Equal(t, "\x1b[32m+added\x1b[m", lines[10])
+}
@@ -323,6 +323,40 @@ type AppState struct {
 	HideCommandLog             bool
 	IgnoreWhitespaceInDiffView bool
 	DiffContextSize            int
+	// how changes within lines are highlighted in diffs: '' (not at all),
+	// 'word' or 'char'
+	WordDiffMode string
+	// the value passed with --diff-algorithm, or '' for git's default
+	DiffAlgorithm        string
+	ColorMovedInDiffView bool
+	// the similarity in percent that --find-renames needs to detect a rename,
+	// or 0 for git's default
+	RenameThreshold      int
+	FindCopiesInDiffView bool
+	// whether the main view shows diffs with the old and the new version next
+	// to each other
+	SideBySideDiff bool
+	// the theme picked from the themes menu, or "" if it's the one the config
+	// selects anyway. It overrides the config only as long as that stays the
+	// way it was when the theme was picked.
+	ThemeName string
+	// the theme that the config selected when ThemeName was picked
+	ConfigThemeName string
+	// the values passed with --push-option from the push menu, most recent last
+	PushOptionsHistory []string
+	// snapshots of changes that were discarded, so that they can be recovered,
+	// most recent last
+	DiscardedChanges []DiscardedChangesSnapshot
+}
+
+// The content of some files in the working tree right before discarding
+// their changes, stored as a commit that isn't referenced by any ref
+type DiscardedChangesSnapshot struct {
+	RepoPath string
+	Sha      string
+	// e.g. 'Discard all changes in file: file.txt'
+	Description   string
+	UnixTimestamp int64
 }
 
 func getDefaultAppState() *AppState {
@@ -28,6 +28,9 @@ type UserConfig struct {
 	DisableStartupPopups bool `yaml:"disableStartupPopups"`
 	// User-configured commands that can be invoked from within Lazygit
 	CustomCommands []CustomCommand `yaml:"customCommands" jsonschema:"uniqueItems=true"`
+	// External programs that register keybindings and menu items, talking to Lazygit over JSON-RPC on stdio.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Plugins.md
+	Plugins []PluginConfig `yaml:"plugins"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	Services map[string]string `yaml:"services"`
 	// What to do when opening Lazygit outside of a git repo.
@@ -38,6 +41,18 @@ type UserConfig struct {
 	NotARepository string `yaml:"notARepository" jsonschema:"enum=prompt,enum=create,enum=skip,enum=quit"`
 	// If true, display a confirmation when subprocess terminates. This allows you to view the output of the subprocess before returning to Lazygit.
 	PromptToReturnFromSubprocess bool `yaml:"promptToReturnFromSubprocess"`
+	// Recording of actions and commands on disk, for finding out afterwards what was run.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#audit-log
+	AuditLog AuditLogConfig `yaml:"auditLog"`
+}
+
+type AuditLogConfig struct {
+	// If true, append every action and command shown in the command log to 'audit.log' in the config directory
+	Enabled bool `yaml:"enabled"`
+	// Size in kilobytes after which the log file is rotated
+	MaxFileSizeKB int `yaml:"maxFileSizeKB" jsonschema:"minimum=1"`
+	// Number of rotated log files to keep
+	MaxFiles int `yaml:"maxFiles" jsonschema:"minimum=0"`
 }
 
 type RefresherConfig struct {
@@ -47,6 +62,9 @@ type RefresherConfig struct {
 	// Re-fetch interval in seconds.
 	// Auto-fetch can be disabled via option 'git.autoFetch'.
 	FetchInterval int `yaml:"fetchInterval" jsonschema:"minimum=0"`
+	// Time in seconds after which a background fetch is terminated, e.g. when
+	// an SSH connection hangs. 0 means no timeout.
+	FetchTimeout int `yaml:"fetchTimeout" jsonschema:"minimum=0"`
 }
 
 type GuiConfig struct {
@@ -95,6 +113,17 @@ type GuiConfig struct {
 	// Config relating to colors and styles.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#color-attributes
 	Theme ThemeConfig `yaml:"theme"`
+	// Name of a theme to use instead of the colors in 'theme'. One of the
+	// bundled themes ('light', 'dark', 'high-contrast', 'solarized', 'colorblind') or the name
+	// of a theme file in the 'themes' folder of the config directory, without
+	// the extension. A theme picked from the themes menu takes precedence.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#themes
+	ThemeName string `yaml:"themeName"`
+	// If true, add symbols wherever a distinction is otherwise only shown by
+	// color (e.g. staged vs unstaged files, or pushed vs unpushed commits), and
+	// use the colorblind-safe 'colorblind' theme unless another theme is picked.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#accessible-mode
+	AccessibleMode bool `yaml:"accessibleMode"`
 	// Config relating to the commit length indicator
 	CommitLength CommitLengthConfig `yaml:"commitLength"`
 	// If true, show the '5 of 20' footer at the bottom of list views
@@ -164,6 +193,35 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color for file with only staged changes
+	StagedChangesColor []string `yaml:"stagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color for directory with both staged and unstaged changes
+	PartiallyStagedChangesColor []string `yaml:"partiallyStagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of an added file in the commit files view
+	AddedFileColor []string `yaml:"addedFileColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of a modified or renamed file in the commit files view
+	ModifiedFileColor []string `yaml:"modifiedFileColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of a deleted file in the commit files view
+	DeletedFileColor []string `yaml:"deletedFileColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of a copied file in the commit files view
+	CopiedFileColor []string `yaml:"copiedFileColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the status of a file whose type changed in the commit files view
+	TypeChangedFileColor []string `yaml:"typeChangedFileColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the file header of diffs shown in the staging and patch building views
+	DiffHeaderColor []string `yaml:"diffHeaderColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of hunk headers in the staging and patch building views
+	DiffHunkHeaderColor []string `yaml:"diffHunkHeaderColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of added lines in the staging and patch building views
+	DiffAddedLineColor []string `yaml:"diffAddedLineColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of removed lines in the staging and patch building views
+	DiffRemovedLineColor []string `yaml:"diffRemovedLineColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Colors to pick author colors from, based on the author's name. Authors
+	// configured in 'authorColors' keep their color. If empty, a random
+	// true color is generated from the name.
+	AuthorColorPalette []string `yaml:"authorColorPalette"`
+	// Colors to pick the colors of the commit graph from, based on the commit
+	// author's name. If empty, the graph uses the author colors.
+	GraphColorPalette []string `yaml:"graphColorPalette"`
 }
 
 type CommitLengthConfig struct {
@@ -188,6 +246,8 @@ type GitConfig struct {
 	AutoRefresh bool `yaml:"autoRefresh"`
 	// If true, pass the --all arg to git fetch
 	FetchAll bool `yaml:"fetchAll"`
+	// If true, show the remote branches and tags that were updated, created or deleted after fetching
+	ShowFetchSummary bool `yaml:"showFetchSummary"`
 	// Command used when displaying the current branch git log in the main window
 	BranchLogCmd string `yaml:"branchLogCmd"`
 	// Command used to display git log of all branches in the main window
@@ -252,6 +312,11 @@ type LogConfig struct {
 	ShowGraph string `yaml:"showGraph" jsonschema:"enum=always,enum=never,enum=when-maximised"`
 	// displays the whole git graph by default in the commits view (equivalent to passing the `--all` argument to `git log`)
 	ShowWholeGraph bool `yaml:"showWholeGraph"`
+	// If true, show whether each commit in the commits panel is signed (and by
+	// whom, when the panel is expanded), and include the signature verification
+	// output in the commit details. This makes loading commits slower because git
+	// has to verify every signature.
+	ShowSignatureStatus bool `yaml:"showSignatureStatus"`
 }
 
 type CommitPrefixConfig struct {
@@ -329,6 +394,9 @@ type KeybindingUniversalConfig struct {
 	CreateRebaseOptionsMenu      string   `yaml:"createRebaseOptionsMenu"`
 	Push                         string   `yaml:"pushFiles"` // 'Files' appended for legacy reasons
 	Pull                         string   `yaml:"pullFiles"` // 'Files' appended for legacy reasons
+	PushMenu                     string   `yaml:"pushMenu"`
+	PullMenu                     string   `yaml:"pullMenu"`
+	CancelCommand                string   `yaml:"cancelCommand"`
 	Refresh                      string   `yaml:"refresh"`
 	CreatePatchOptionsMenu       string   `yaml:"createPatchOptionsMenu"`
 	NextTab                      string   `yaml:"nextTab"`
@@ -344,7 +412,11 @@ type KeybindingUniversalConfig struct {
 	OpenRecentRepos              string   `yaml:"openRecentRepos"`
 	SubmitEditorText             string   `yaml:"submitEditorText"`
 	ExtrasMenu                   string   `yaml:"extrasMenu"`
+	OpenPluginsMenu              string   `yaml:"openPluginsMenu"`
+	OpenCommandPalette           string   `yaml:"openCommandPalette"`
 	ToggleWhitespaceInDiffView   string   `yaml:"toggleWhitespaceInDiffView"`
+	CycleWordDiffInDiffView      string   `yaml:"cycleWordDiffInDiffView"`
+	DiffOptionsMenu              string   `yaml:"diffOptionsMenu"`
 	IncreaseContextInDiffView    string   `yaml:"increaseContextInDiffView"`
 	DecreaseContextInDiffView    string   `yaml:"decreaseContextInDiffView"`
 }
@@ -353,6 +425,7 @@ type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	SwitchTheme         string `yaml:"switchTheme"`
 }
 
 type KeybindingFilesConfig struct {
@@ -372,6 +445,7 @@ type KeybindingFilesConfig struct {
 	OpenMergeTool            string `yaml:"openMergeTool"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
+	ViewLfsOptions           string `yaml:"viewLfsOptions"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -389,6 +463,10 @@ type KeybindingBranchesConfig struct {
 	PushTag                string `yaml:"pushTag"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	ViewRemoteOptions      string `yaml:"viewRemoteOptions"`
+	CleanUpBranches        string `yaml:"cleanUpBranches"`
+	CreateSignedTag        string `yaml:"createSignedTag"`
+	CompareTagsWithRemote  string `yaml:"compareTagsWithRemote"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -420,6 +498,7 @@ type KeybindingCommitsConfig struct {
 	OpenLogMenu                    string `yaml:"openLogMenu"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
+	ViewRecoveryOptions            string `yaml:"viewRecoveryOptions"`
 }
 
 type KeybindingStashConfig struct {
@@ -513,6 +592,13 @@ type CustomCommandAfterHook struct {
 	CheckForConflicts bool `yaml:"checkForConflicts"`
 }
 
+type PluginConfig struct {
+	// Used in error messages and in the log
+	Name string `yaml:"name"`
+	// The command that starts the plugin. It is run in a shell from the repo's directory.
+	Command string `yaml:"command"`
+}
+
 type CustomCommand struct {
 	// The key to trigger the command. Use a single letter or one of the values from https://github.com/jesseduffield/lazygit/blob/master/docs/keybindings/Custom_Keybindings.md
 	Key string `yaml:"key"`
@@ -524,6 +610,8 @@ type CustomCommand struct {
 	Subprocess bool `yaml:"subprocess"`
 	// A list of prompts that will request user input before running the final command
 	Prompts []CustomCommandPrompt `yaml:"prompts"`
+	// A list of steps to run before the final command, each of which either shows a prompt or runs a command. Steps can be skipped with an `if` condition, and a failing step aborts the rest. Mutually exclusive with prompts. If steps are given, the final command is optional
+	Steps []CustomCommandStep `yaml:"steps"`
 	// Text to display while waiting for command to finish
 	LoadingText string `yaml:"loadingText" jsonschema:"example=Loading..."`
 	// Label for the custom command when displayed in the keybindings menu
@@ -534,18 +622,57 @@ type CustomCommand struct {
 	ShowOutput bool `yaml:"showOutput"`
 	// Actions to take after the command has completed
 	After CustomCommandAfterHook `yaml:"after"`
+	// If set, the command's output is parsed as a JSON array and shown as a menu with one entry per element, instead of being shown raw. Takes precedence over showOutput
+	OutputMenu *CustomCommandOutputMenu `yaml:"outputMenu,omitempty"`
+}
+
+type CustomCommandOutputMenu struct {
+	// The title of the menu. Defaults to the command's description
+	Title string `yaml:"title"`
+	// Templates for the columns of each entry. The JSON element is available as {{.Item}}, e.g. {{.Item.title}}. If empty, each element is shown as JSON
+	Columns []string `yaml:"columns" jsonschema:"example={{.Item.number}},example={{.Item.title}}"`
+	// Commands to offer for the chosen entry. Without actions the menu only displays the output; with a single action, choosing an entry runs it directly; otherwise a menu of the actions is shown
+	Actions []CustomCommandOutputAction `yaml:"actions"`
+}
+
+type CustomCommandOutputAction struct {
+	// The key to select the action in the actions menu
+	Key string `yaml:"key"`
+	// Label of the action in the actions menu
+	Description string `yaml:"description"`
+	// The command to run. The chosen JSON element is available as {{.Item}} in addition to the usual placeholders
+	Command string `yaml:"command" jsonschema:"example=gh pr checkout {{.Item.number}}"`
+	// If true, run the command in a subprocess (e.g. if the command requires user input)
+	Subprocess bool `yaml:"subprocess"`
+	// Text to display while waiting for command to finish
+	LoadingText string `yaml:"loadingText" jsonschema:"example=Loading..."`
+	// If true, show the command's output in a popup within Lazygit
+	ShowOutput bool `yaml:"showOutput"`
+}
+
+type CustomCommandStep struct {
+	// A Go template pipeline that decides whether the step is run, evaluated over the same values as the command (e.g. the form values of earlier steps). If empty, the step is always run
+	If string `yaml:"if" jsonschema:"example=eq .Form.BranchType \"feature\""`
+	// A prompt to show. Its response is stored in the form under the prompt's key. Mutually exclusive with command
+	Prompt *CustomCommandPrompt `yaml:"prompt,omitempty"`
+	// A command to run. Mutually exclusive with prompt
+	Command string `yaml:"command" jsonschema:"example=git rev-parse --abbrev-ref {{.SelectedLocalBranch.Name}}@{upstream}"`
+	// If set, the command's output (with surrounding whitespace trimmed) is stored in the form under this key
+	Key string `yaml:"key"`
+	// Text to display while waiting for the command to finish
+	LoadingText string `yaml:"loadingText" jsonschema:"example=Loading..."`
 }
 
 type CustomCommandPrompt struct {
-	// One of: 'input' | 'menu' | 'confirm' | 'menuFromCommand'
-	Type string `yaml:"type"`
+	// One of: 'input' | 'menu' | 'confirm' | 'menuFromCommand' | 'checklist' | 'filePicker' | 'multilineInput'
+	Type string `yaml:"type" jsonschema:"enum=input,enum=menu,enum=confirm,enum=menuFromCommand,enum=checklist,enum=filePicker,enum=multilineInput"`
 	// Used to reference the entered value from within the custom command. E.g. a prompt with `key: 'Branch'` can be referred to as `{{.Form.Branch}}` in the command
 	Key string `yaml:"key"`
 	// The title to display in the popup panel
 	Title string `yaml:"title"`
 
 	// The initial value to appear in the text box.
-	// Only for input prompts.
+	// Only for input, filePicker and multilineInput prompts.
 	InitialValue string `yaml:"initialValue"`
 	// Shows suggestions as the input is entered
 	// Only for input prompts.
@@ -556,20 +683,20 @@ type CustomCommandPrompt struct {
 	Body string `yaml:"body" jsonschema:"example=Are you sure you want to push to the remote?"`
 
 	// Menu options.
-	// Only for menu prompts.
+	// Only for menu and checklist prompts.
 	Options []CustomCommandMenuOption
 
 	// The command to run to generate menu options
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and checklist prompts.
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
 	// The regexp to run specifying groups which are going to be kept from the command's output.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and checklist prompts.
 	Filter string `yaml:"filter" jsonschema:"example=.*{{.SelectedRemote.Name }}/(?P<branch>.*)"`
 	// How to format matched groups from the filter to construct a menu item's value.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and checklist prompts.
 	ValueFormat string `yaml:"valueFormat" jsonschema:"example={{ .branch }}"`
 	// Like valueFormat but for the labels. If `labelFormat` is not specified, `valueFormat` is shown instead.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and checklist prompts.
 	LabelFormat string `yaml:"labelFormat" jsonschema:"example={{ .branch | green }}"`
 }
 
@@ -606,19 +733,34 @@ func GetDefaultConfig() *UserConfig {
 			TimeFormat:               "02 Jan 06",
 			ShortTimeFormat:          time.Kitchen,
 			Theme: ThemeConfig{
-				ActiveBorderColor:          []string{"green", "bold"},
-				SearchingActiveBorderColor: []string{"cyan", "bold"},
-				InactiveBorderColor:        []string{"default"},
-				OptionsTextColor:           []string{"blue"},
-				SelectedLineBgColor:        []string{"blue"},
-				SelectedRangeBgColor:       []string{"blue"},
-				CherryPickedCommitBgColor:  []string{"cyan"},
-				CherryPickedCommitFgColor:  []string{"blue"},
-				MarkedBaseCommitBgColor:    []string{"yellow"},
-				MarkedBaseCommitFgColor:    []string{"blue"},
-				UnstagedChangesColor:       []string{"red"},
-				DefaultFgColor:             []string{"default"},
+				ActiveBorderColor:           []string{"green", "bold"},
+				SearchingActiveBorderColor:  []string{"cyan", "bold"},
+				InactiveBorderColor:         []string{"default"},
+				OptionsTextColor:            []string{"blue"},
+				SelectedLineBgColor:         []string{"blue"},
+				SelectedRangeBgColor:        []string{"blue"},
+				CherryPickedCommitBgColor:   []string{"cyan"},
+				CherryPickedCommitFgColor:   []string{"blue"},
+				MarkedBaseCommitBgColor:     []string{"yellow"},
+				MarkedBaseCommitFgColor:     []string{"blue"},
+				UnstagedChangesColor:        []string{"red"},
+				DefaultFgColor:              []string{"default"},
+				StagedChangesColor:          []string{"green"},
+				PartiallyStagedChangesColor: []string{"yellow"},
+				AddedFileColor:              []string{"green"},
+				ModifiedFileColor:           []string{"yellow"},
+				DeletedFileColor:            []string{"red"},
+				CopiedFileColor:             []string{"cyan"},
+				TypeChangedFileColor:        []string{"magenta"},
+				DiffHeaderColor:             []string{"bold"},
+				DiffHunkHeaderColor:         []string{"cyan"},
+				DiffAddedLineColor:          []string{"green"},
+				DiffRemovedLineColor:        []string{"red"},
+				AuthorColorPalette:          []string{},
+				GraphColorPalette:           []string{},
 			},
+			ThemeName:                 "",
+			AccessibleMode:            false,
 			CommitLength:              CommitLengthConfig{Show: true},
 			SkipNoStagedFilesWarning:  false,
 			ShowListFooter:            true,
@@ -652,15 +794,17 @@ func GetDefaultConfig() *UserConfig {
 				Args:         "",
 			},
 			Log: LogConfig{
-				Order:          "topo-order",
-				ShowGraph:      "when-maximised",
-				ShowWholeGraph: false,
+				Order:               "topo-order",
+				ShowGraph:           "when-maximised",
+				ShowWholeGraph:      false,
+				ShowSignatureStatus: false,
 			},
 			SkipHookPrefix:      "WIP",
 			MainBranches:        []string{"master", "main"},
 			AutoFetch:           true,
 			AutoRefresh:         true,
 			FetchAll:            true,
+			ShowFetchSummary:    true,
 			BranchLogCmd:        "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
 			AllBranchesLogCmd:   "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
 			DisableForcePushing: false,
@@ -670,6 +814,7 @@ func GetDefaultConfig() *UserConfig {
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
 			FetchInterval:   60,
+			FetchTimeout:    120,
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
@@ -725,6 +870,9 @@ func GetDefaultConfig() *UserConfig {
 				CreateRebaseOptionsMenu:      "m",
 				Push:                         "P",
 				Pull:                         "p",
+				PushMenu:                     "U",
+				PullMenu:                     "G",
+				CancelCommand:                "<c-t>",
 				Refresh:                      "R",
 				CreatePatchOptionsMenu:       "<c-p>",
 				NextTab:                      "]",
@@ -739,7 +887,11 @@ func GetDefaultConfig() *UserConfig {
 				CopyToClipboard:              "<c-o>",
 				SubmitEditorText:             "<enter>",
 				ExtrasMenu:                   "@",
+				OpenPluginsMenu:              "<c-x>",
+				OpenCommandPalette:           "<c-g>",
 				ToggleWhitespaceInDiffView:   "<c-w>",
+				CycleWordDiffInDiffView:      "<c-n>",
+				DiffOptionsMenu:              "Y",
 				IncreaseContextInDiffView:    "}",
 				DecreaseContextInDiffView:    "{",
 			},
@@ -747,6 +899,7 @@ func GetDefaultConfig() *UserConfig {
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				SwitchTheme:         "T",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -765,6 +918,7 @@ func GetDefaultConfig() *UserConfig {
 				OpenStatusFilter:         "<c-b>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
+				ViewLfsOptions:           "F",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -781,6 +935,10 @@ func GetDefaultConfig() *UserConfig {
 				PushTag:                "P",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				ViewRemoteOptions:      "o",
+				CleanUpBranches:        "D",
+				CreateSignedTag:        "S",
+				CompareTagsWithRemote:  "s",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -810,6 +968,7 @@ func GetDefaultConfig() *UserConfig {
 				OpenLogMenu:                    "<c-l>",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
+				ViewRecoveryOptions:            "u",
 			},
 			Stash: KeybindingStashConfig{
 				PopStash:    "g",
@@ -837,8 +996,14 @@ func GetDefaultConfig() *UserConfig {
 		OS:                           OSConfig{},
 		DisableStartupPopups:         false,
 		CustomCommands:               []CustomCommand(nil),
+		Plugins:                      []PluginConfig(nil),
 		Services:                     map[string]string(nil),
 		NotARepository:               "prompt",
 		PromptToReturnFromSubprocess: true,
+		AuditLog: AuditLogConfig{
+			Enabled:       false,
+			MaxFileSizeKB: 1024,
+			MaxFiles:      5,
+		},
 	}
 }
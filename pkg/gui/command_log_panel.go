@@ -3,9 +3,13 @@ package gui
 import (
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/auditlog"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -24,6 +28,8 @@ import (
 // We pass logCommand to our OSCommand struct so that it can handle logging commands
 // for us.
 func (gui *Gui) LogAction(action string) {
+	gui.AuditLog.LogAction(action)
+
 	if gui.Views.Extras == nil {
 		return
 	}
@@ -52,6 +58,23 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
 }
 
+// Commands are recorded in the audit log once they've finished, so that their
+// exit code and duration are known. That's done by the OSCommand, except for
+// subprocesses, which we run ourselves.
+func newAuditLog(cmn *common.Common, config config.AppConfigurer) *auditlog.Logger {
+	auditLogConfig := cmn.UserConfig.AuditLog
+	if !auditLogConfig.Enabled {
+		return auditlog.NewNullLogger()
+	}
+
+	return auditlog.New(
+		cmn.Log,
+		filepath.Join(config.GetUserConfigDir(), auditlog.FileName),
+		int64(auditLogConfig.MaxFileSizeKB)*1024,
+		auditLogConfig.MaxFiles,
+	)
+}
+
 func (gui *Gui) printCommandLogHeader() {
 	introStr := fmt.Sprintf(
 		gui.c.Tr.CommandLogHeader,
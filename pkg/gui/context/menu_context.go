@@ -59,6 +59,7 @@ type MenuViewModel struct {
 	c               *ContextCommon
 	menuItems       []*types.MenuItem
 	columnAlignment []utils.Alignment
+	handleClose     func() error
 	*FilteredListViewModel[*types.MenuItem]
 }
 
@@ -81,6 +82,10 @@ func (self *MenuViewModel) SetMenuItems(items []*types.MenuItem, columnAlignment
 	self.columnAlignment = columnAlignment
 }
 
+func (self *MenuViewModel) SetHandleClose(handleClose func() error) {
+	self.handleClose = handleClose
+}
+
 // TODO: move into presentation package
 func (self *MenuViewModel) GetDisplayStrings(_ int, _ int) [][]string {
 	menuItems := self.FilteredListViewModel.GetItems()
@@ -171,6 +176,18 @@ func (self *MenuContext) GetKeybindings(opts types.KeybindingsOpts) []*types.Bin
 	return append(basicBindings, menuItemBindings...)
 }
 
+func (self *MenuContext) OnMenuClose() error {
+	if err := self.c.PopContext(); err != nil {
+		return err
+	}
+
+	if self.handleClose == nil {
+		return nil
+	}
+
+	return self.handleClose()
+}
+
 func (self *MenuContext) OnMenuPress(selectedItem *types.MenuItem) error {
 	if selectedItem != nil && selectedItem.DisabledReason != "" {
 		return self.c.ErrorMsg(selectedItem.DisabledReason)
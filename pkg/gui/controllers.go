@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/services/plugins"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -94,7 +95,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
-		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper),
+		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
 		BranchesHelper:  helpers.NewBranchesHelper(helperCommon),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
@@ -121,9 +122,16 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Search:           searchHelper,
+		Worktree:         worktreeHelper,
+		SubCommits:       helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Checklist:        helpers.NewChecklistHelper(helperCommon),
+		Theme:            helpers.NewThemeHelper(helperCommon),
+		AuditLog:         helpers.NewAuditLogHelper(helperCommon, searchHelper),
+		Fetch:            helpers.NewFetchHelper(helperCommon, refsHelper),
+		Journal:          helpers.NewJournalHelper(helperCommon),
+		DiscardedChanges: helpers.NewDiscardedChangesHelper(helperCommon),
+		Keybindings:      helpers.NewKeybindingsHelper(helperCommon),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -131,6 +139,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.helpers,
 	)
 
+	if gui.PluginsClient == nil {
+		gui.PluginsClient = plugins.NewClient(
+			helperCommon,
+			func() *helpers.Helpers { return gui.helpers },
+		)
+	}
+
 	common := controllers.NewControllerCommon(helperCommon, gui)
 
 	syncController := controllers.NewSyncController(
@@ -56,10 +56,11 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description: self.c.Tr.CreateNewBranchFromCommit,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Commits.ViewResetOptions),
-			Handler:     self.checkSelected(self.createResetMenu),
-			Description: self.c.Tr.ViewResetOptions,
-			OpensMenu:   true,
+			Key:          opts.GetKey(opts.Config.Commits.ViewResetOptions),
+			Handler:      self.checkSelected(self.createResetMenu),
+			Description:  self.c.Tr.ViewResetOptions,
+			OpensMenu:    true,
+			GetMenuItems: self.resetMenuItems,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.CherryPickCopy),
@@ -233,6 +234,15 @@ func (self *BasicCommitsController) createResetMenu(commit *models.Commit) error
 	return self.c.Helpers().Refs.CreateGitResetMenu(commit.Sha)
 }
 
+func (self *BasicCommitsController) resetMenuItems() []*types.MenuItem {
+	commit := self.context.GetSelected()
+	if commit == nil {
+		return nil
+	}
+
+	return self.c.Helpers().Refs.GitResetMenuItems(commit.Sha)
+}
+
 func (self *BasicCommitsController) checkout(commit *models.Commit) error {
 	return self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.CheckoutCommit,
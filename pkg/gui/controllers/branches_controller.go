@@ -98,10 +98,11 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.CreateTag,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Commits.ViewResetOptions),
-			Handler:     self.checkSelected(self.createResetMenu),
-			Description: self.c.Tr.ViewResetOptions,
-			OpensMenu:   true,
+			Key:          opts.GetKey(opts.Config.Commits.ViewResetOptions),
+			Handler:      self.checkSelected(self.createResetMenu),
+			Description:  self.c.Tr.ViewResetOptions,
+			OpensMenu:    true,
+			GetMenuItems: self.resetMenuItems,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Branches.RenameBranch),
@@ -115,6 +116,15 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Tooltip:     self.c.Tr.ViewBranchUpstreamOptionsTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CleanUpBranches),
+			Handler:     self.cleanUpBranches,
+			Description: self.c.Tr.CleanUpBranches,
+			Tooltip: utils.ResolvePlaceholderString(self.c.Tr.CleanUpBranchesTooltip, map[string]string{
+				"mainBranches": strings.Join(self.c.UserConfig.Git.MainBranches, ", "),
+			}),
+			OpensMenu: true,
+		},
 	}
 }
 
@@ -479,6 +489,7 @@ func (self *BranchesController) localDelete(branch *models.Branch) error {
 		if err != nil {
 			return self.c.Error(err)
 		}
+		self.c.Helpers().Journal.RecordBranchDeletion([]*models.Branch{branch})
 		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
 	})
 }
@@ -503,6 +514,7 @@ func (self *BranchesController) forceDelete(branch *models.Branch) error {
 			if err := self.c.Git().Branch.LocalDelete(branch.Name, true); err != nil {
 				return self.c.ErrorMsg(err.Error())
 			}
+			self.c.Helpers().Journal.RecordBranchDeletion([]*models.Branch{branch})
 			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
 		},
 	})
@@ -546,6 +558,138 @@ func (self *BranchesController) delete(branch *models.Branch) error {
 	})
 }
 
+func (self *BranchesController) cleanUpBranches() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FindingBranchesToCleanUpStatus, func(gocui.Task) error {
+		items, unmergedBranchNames, err := self.findBranchesToCleanUp()
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		if len(items) == 0 {
+			self.c.Toast(self.c.Tr.NoBranchesToCleanUp)
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.Helpers().Checklist.Show(helpers.ChecklistOpts{
+				Title:        self.c.Tr.BranchesToCleanUpTitle,
+				Items:        items,
+				ConfirmLabel: self.c.Tr.DeleteSelectedBranches,
+				HandleConfirm: func(items []*helpers.ChecklistItem) error {
+					return self.confirmDeleteBranches(items, unmergedBranchNames)
+				},
+			})
+		})
+		return nil
+	})
+}
+
+// finds local branches which are merged into a main branch (directly or by
+// squashing/rebasing) or whose upstream has been deleted. Also returns the names
+// of the branches whose upstream is gone but which aren't merged in any way,
+// because deleting those loses their commits.
+func (self *BranchesController) findBranchesToCleanUp() ([]*helpers.ChecklistItem, []string, error) {
+	mainBranchRefs := self.c.Git().Branch.MainBranchRefs()
+
+	// maps branch names to the first main branch ref they're merged into
+	mergedInto := map[string]string{}
+	for _, ref := range mainBranchRefs {
+		mergedBranches, err := self.c.Git().Branch.MergedBranches(ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, branchName := range mergedBranches {
+			if _, ok := mergedInto[branchName]; !ok {
+				mergedInto[branchName] = ref
+			}
+		}
+	}
+
+	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
+
+	items := []*helpers.ChecklistItem{}
+	unmergedBranchNames := []string{}
+	for _, branch := range self.c.Model().Branches {
+		if !branch.IsRealBranch() || lo.Contains(self.c.UserConfig.Git.MainBranches, branch.Name) {
+			continue
+		}
+
+		reason := ""
+		if ref, ok := mergedInto[branch.Name]; ok {
+			reason = utils.ResolvePlaceholderString(self.c.Tr.CleanUpReasonMerged, map[string]string{"ref": shortRefName(ref)})
+		} else if ref, ok := lo.Find(mainBranchRefs, func(ref string) bool {
+			return self.c.Git().Branch.IsSquashMerged(branch.Name, ref)
+		}); ok {
+			reason = utils.ResolvePlaceholderString(self.c.Tr.CleanUpReasonSquashMerged, map[string]string{"ref": shortRefName(ref)})
+		} else if branch.UpstreamGone {
+			reason = self.c.Tr.CleanUpReasonUpstreamGone
+			unmergedBranchNames = append(unmergedBranchNames, branch.Name)
+		}
+		if reason == "" {
+			continue
+		}
+
+		item := &helpers.ChecklistItem{Label: branch.Name, Description: reason}
+		if checkedOutBranch != nil && checkedOutBranch.Name == branch.Name {
+			item.DisabledReason = self.c.Tr.CantDeleteCheckOutBranch
+		} else if worktree, ok := self.worktreeForBranch(branch); ok && !worktree.IsCurrent {
+			item.Description += ", " + utils.ResolvePlaceholderString(self.c.Tr.CheckedOutInWorktree, map[string]string{"worktreeName": worktree.Name})
+			item.DisabledReason = utils.ResolvePlaceholderString(self.c.Tr.BranchCheckedOutByWorktree, map[string]string{
+				"worktreeName": worktree.Name,
+				"branchName":   branch.Name,
+			})
+		}
+		items = append(items, item)
+	}
+
+	return items, unmergedBranchNames, nil
+}
+
+func (self *BranchesController) confirmDeleteBranches(items []*helpers.ChecklistItem, unmergedBranchNames []string) error {
+	branchNames := lo.Map(items, func(item *helpers.ChecklistItem, _ int) string { return item.Label })
+	branches := lo.Filter(self.c.Model().Branches, func(branch *models.Branch, _ int) bool {
+		return lo.Contains(branchNames, branch.Name)
+	})
+
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.DeleteLocalBranchesPrompt, map[string]string{
+		"branches": strings.Join(branchNames, "\n"),
+	})
+	if unmerged := lo.Intersect(branchNames, unmergedBranchNames); len(unmerged) > 0 {
+		prompt += "\n\n" + utils.ResolvePlaceholderString(self.c.Tr.DeleteUnmergedBranchesWarning, map[string]string{
+			"branches": strings.Join(unmerged, "\n"),
+		})
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DeleteSelectedBranches,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranches)
+				// squash-merged branches and branches with a deleted upstream aren't
+				// considered merged by git, so we need to force the deletion. The
+				// prompt has warned about the ones that aren't merged at all.
+				if err := self.c.Git().Branch.LocalDeleteMultiple(branchNames, true); err != nil {
+					_ = self.c.Error(err)
+				} else {
+					self.c.Helpers().Journal.RecordBranchDeletion(branches)
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+			})
+		},
+	})
+}
+
+// e.g. 'refs/remotes/origin/master' -> 'origin/master'
+func shortRefName(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/remotes/", "refs/tags/"} {
+		if strings.HasPrefix(ref, prefix) {
+			return strings.TrimPrefix(ref, prefix)
+		}
+	}
+	return ref
+}
+
 func (self *BranchesController) merge() error {
 	selectedBranchName := self.context().GetSelected().Name
 	return self.c.Helpers().MergeAndRebase.MergeRefIntoCheckedOutBranch(selectedBranchName)
@@ -621,6 +765,15 @@ func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) e
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name)
 }
 
+func (self *BranchesController) resetMenuItems() []*types.MenuItem {
+	selectedBranch := self.context().GetSelected()
+	if selectedBranch == nil {
+		return nil
+	}
+
+	return self.c.Helpers().Refs.GitResetMenuItems(selectedBranch.Name)
+}
+
 func (self *BranchesController) rename(branch *models.Branch) error {
 	promptForNewName := func() error {
 		return self.c.Prompt(types.PromptOpts{
@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Lists the actions of all panels, including custom commands and the items of
+// the menus that keybindings open (for those bindings that can provide them
+// without opening the menu), and opens the filter prompt so that they can be
+// fuzzy-searched.
+type CommandPaletteAction struct {
+	c *ControllerCommon
+}
+
+type commandPaletteEntry struct {
+	// nil for global keybindings, which don't need a context switch
+	context  types.Context
+	binding  *types.Binding
+	menuItem *types.MenuItem
+}
+
+func (self *CommandPaletteAction) Call() error {
+	currentContext := self.c.CurrentContext()
+	// Don't show the palette while displaying a popup.
+	if currentContext.GetKind() == types.PERSISTENT_POPUP || currentContext.GetKind() == types.TEMPORARY_POPUP {
+		return nil
+	}
+
+	menuItems := lo.Map(self.getEntries(currentContext), func(entry *commandPaletteEntry, _ int) *types.MenuItem {
+		return self.menuItem(entry)
+	})
+
+	if err := self.c.Menu(types.CreateMenuOptions{
+		Title:           self.c.Tr.CommandPaletteTitle,
+		Items:           menuItems,
+		HideCancel:      true,
+		ColumnAlignment: []utils.Alignment{utils.AlignRight, utils.AlignLeft, utils.AlignLeft},
+	}); err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Search.OpenFilterPrompt(self.c.Contexts().Menu)
+}
+
+// Returns the entries of the current context first, then the global ones, then
+// those of the other side panels. The keybindings of contexts that are neither
+// focused nor side panels that can be switched to directly (e.g. the staging
+// view or the files of a commit) are left out, because they need some state to
+// be set up first.
+func (self *CommandPaletteAction) getEntries(currentContext types.Context) []*commandPaletteEntry {
+	bindings := lo.Filter(self.c.Helpers().Keybindings.Bindings(), func(binding *types.Binding, _ int) bool {
+		return binding.Description != "" &&
+			binding.Tag != "navigation" &&
+			binding.Description != self.c.Tr.OpenCommandPalette
+	})
+	bindings = lo.UniqBy(bindings, func(binding *types.Binding) string {
+		return binding.ViewName + "\x00" + binding.Description
+	})
+
+	var local, global, other []*commandPaletteEntry
+	for _, binding := range bindings {
+		if binding.ViewName == "" {
+			global = append(global, self.entriesForBinding(nil, binding)...)
+			continue
+		}
+
+		context, ok := self.contextForBinding(binding, currentContext)
+		if !ok {
+			continue
+		}
+
+		if context == currentContext {
+			local = append(local, self.entriesForBinding(context, binding)...)
+		} else {
+			other = append(other, self.entriesForBinding(context, binding)...)
+		}
+	}
+
+	return append(append(local, global...), other...)
+}
+
+func (self *CommandPaletteAction) contextForBinding(binding *types.Binding, currentContext types.Context) (types.Context, bool) {
+	if binding.ViewName == currentContext.GetViewName() {
+		return currentContext, true
+	}
+
+	return lo.Find(self.c.Contexts().Flatten(), func(context types.Context) bool {
+		return context.GetViewName() == binding.ViewName &&
+			context.GetKind() == types.SIDE_CONTEXT &&
+			!context.IsTransient()
+	})
+}
+
+// Returns an entry for the binding, followed by one for each item of the menu
+// that the binding opens, if any
+func (self *CommandPaletteAction) entriesForBinding(context types.Context, binding *types.Binding) []*commandPaletteEntry {
+	entries := []*commandPaletteEntry{{context: context, binding: binding}}
+	if binding.GetMenuItems == nil || disabledReason(binding) != "" {
+		return entries
+	}
+
+	for _, menuItem := range binding.GetMenuItems() {
+		entries = append(entries, &commandPaletteEntry{context: context, binding: binding, menuItem: menuItem})
+	}
+
+	return entries
+}
+
+func (self *CommandPaletteAction) menuItem(entry *commandPaletteEntry) *types.MenuItem {
+	key := keybindings.LabelFromKey(entry.binding.Key)
+	description := entry.binding.Description
+	tooltip := entry.binding.Tooltip
+	reason := disabledReason(entry.binding)
+	opensMenu := entry.binding.OpensMenu
+	onPress := func() error { return self.c.IGuiCommon.CallKeybindingHandler(entry.binding) }
+
+	if entry.menuItem != nil {
+		if entry.menuItem.Key != nil {
+			key += " " + keybindings.LabelFromKey(entry.menuItem.Key)
+		}
+		description += " > " + menuItemLabel(entry.menuItem)
+		tooltip = entry.menuItem.Tooltip
+		reason = entry.menuItem.DisabledReason
+		opensMenu = entry.menuItem.OpensMenu
+		onPress = func() error {
+			if entry.menuItem.DisabledReason != "" {
+				return self.c.ErrorMsg(entry.menuItem.DisabledReason)
+			}
+			return entry.menuItem.OnPress()
+		}
+	}
+
+	contextName := self.c.Tr.KeybindingsMenuSectionGlobal
+	if entry.context != nil {
+		contextName = contextTitle(entry.context)
+	}
+
+	return &types.MenuItem{
+		LabelColumns: []string{
+			style.FgCyan.Sprint(key),
+			description,
+			style.FgBlue.Sprint(contextName),
+		},
+		OnPress: func() error {
+			if entry.context != nil && entry.context != self.c.CurrentContext() {
+				if err := self.c.PushContext(entry.context); err != nil {
+					return err
+				}
+			}
+
+			return onPress()
+		},
+		OpensMenu:      opensMenu,
+		Tooltip:        tooltip,
+		DisabledReason: reason,
+	}
+}
+
+func disabledReason(binding *types.Binding) string {
+	if binding.GetDisabledReason == nil {
+		return ""
+	}
+	return binding.GetDisabledReason()
+}
+
+func menuItemLabel(menuItem *types.MenuItem) string {
+	if len(menuItem.LabelColumns) > 0 {
+		return strings.Join(menuItem.LabelColumns, " ")
+	}
+	return menuItem.Label
+}
+
+func contextTitle(context types.Context) string {
+	if view := context.GetView(); view != nil && view.Title != "" {
+		return view.Title
+	}
+	return context.GetViewName()
+}
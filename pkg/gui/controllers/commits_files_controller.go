@@ -114,7 +114,7 @@ func (self *CommitFilesController) GetOnRenderToMain() func() error {
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(ref.ParentRefName())
 
 		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
+		task := self.lfsSummaryOrDiffTask(node, from, to, reverse, types.NewRunPtyDiffTask(cmdObj.GetCmd()))
 
 		pair := self.c.MainViewPairs().Normal
 		if node.File != nil {
@@ -133,12 +133,35 @@ func (self *CommitFilesController) GetOnRenderToMain() func() error {
 	}
 }
 
+// Changes to files that are managed by git-lfs are summed up, because the diff
+// of their pointers says nothing about what changed. Whether a file is managed
+// by git-lfs is told by its content, since the .gitattributes of the working
+// tree needn't apply to the commit.
+func (self *CommitFilesController) lfsSummaryOrDiffTask(node *filetree.CommitFileNode, from string, to string, reverse bool, diffTask types.UpdateTask) types.UpdateTask {
+	if node.File == nil {
+		return diffTask
+	}
+
+	path := node.GetPath()
+	return types.NewDeferredTask(func() types.UpdateTask {
+		if change, ok := self.c.Git().Lfs.ObjectChange(from, to, reverse, path); ok {
+			return types.NewRenderStringTask(self.c.Helpers().Diff.LfsObjectChangeSummary(change))
+		}
+		return diffTask
+	})
+}
+
 func (self *CommitFilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
 	node := self.context().GetSelected()
 	if node == nil {
 		return nil
 	}
-	return self.enterCommitFile(node, types.OnFocusOpts{ClickedWindowName: "main", ClickedViewLineIdx: opts.Y})
+	return self.enterCommitFile(node, types.OnFocusOpts{
+		ClickedWindowName:      "main",
+		ClickedViewLineIdx:     opts.Y,
+		ClickedViewColumn:      opts.X,
+		ClickedSideBySideWidth: self.c.Helpers().Diff.SideBySideWidth(self.c.Views().Main),
+	})
 }
 
 func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error {
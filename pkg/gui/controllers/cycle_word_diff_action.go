@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+)
+
+// Cycles between highlighting the changed words within lines of diffs, the
+// changed characters, and nothing
+type CycleWordDiffAction struct {
+	c *ControllerCommon
+}
+
+func (self *CycleWordDiffAction) Call() error {
+	var mode patch.WordDiffMode
+	var message string
+	switch patch.WordDiffMode(self.c.GetAppState().WordDiffMode) {
+	case patch.WordDiffOff:
+		mode, message = patch.WordDiffWords, self.c.Tr.WordDiffWordsEnabled
+	case patch.WordDiffWords:
+		mode, message = patch.WordDiffChars, self.c.Tr.WordDiffCharsEnabled
+	default:
+		mode, message = patch.WordDiffOff, self.c.Tr.WordDiffDisabled
+	}
+
+	self.c.GetAppState().WordDiffMode = string(mode)
+	self.c.SaveAppStateAndLogError()
+	self.c.Toast(message)
+
+	return rerenderDiff(self.c, self.c.CurrentStaticContext())
+}
@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// The diff options menu changes how git computes the diffs shown in the main
+// view. Options are saved in the app state straight away, and the menu is
+// re-opened with the same line selected so that several can be changed in a
+// row.
+type DiffOptionsMenuAction struct {
+	c *ControllerCommon
+}
+
+var diffAlgorithms = []string{"", "myers", "minimal", "patience", "histogram"}
+
+func (self *DiffOptionsMenuAction) Call() error {
+	return self.show(self.c.CurrentStaticContext(), 0)
+}
+
+func (self *DiffOptionsMenuAction) show(diffContext types.Context, selectedLineIdx int) error {
+	appState := self.c.GetAppState()
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.DiffAlgorithm, style.FgCyan.Sprint(self.algorithmLabel(appState.DiffAlgorithm))},
+			OnPress: func() error {
+				return self.selectAlgorithm(diffContext)
+			},
+			Key:       'a',
+			Tooltip:   self.c.Tr.DiffAlgorithmTooltip,
+			OpensMenu: true,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(appState.ColorMovedInDiffView) + " " + self.c.Tr.ColorMovedLines},
+			OnPress: func() error {
+				appState.ColorMovedInDiffView = !appState.ColorMovedInDiffView
+				return self.apply(diffContext, 1)
+			},
+			Key:     'm',
+			Tooltip: self.c.Tr.ColorMovedLinesTooltip,
+		},
+		{
+			LabelColumns: []string{self.c.Tr.RenameThreshold, style.FgCyan.Sprint(self.renameThresholdLabel(appState.RenameThreshold))},
+			OnPress: func() error {
+				return self.promptForRenameThreshold(diffContext)
+			},
+			Key:     'r',
+			Tooltip: self.c.Tr.RenameThresholdTooltip,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(appState.FindCopiesInDiffView) + " " + self.c.Tr.FindCopies},
+			OnPress: func() error {
+				appState.FindCopiesInDiffView = !appState.FindCopiesInDiffView
+				return self.apply(diffContext, 3)
+			},
+			Key:     'c',
+			Tooltip: self.c.Tr.FindCopiesTooltip,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(appState.SideBySideDiff) + " " + self.c.Tr.SideBySideDiff},
+			OnPress: func() error {
+				appState.SideBySideDiff = !appState.SideBySideDiff
+				return self.apply(diffContext, 4)
+			},
+			Key:     's',
+			Tooltip: self.c.Tr.SideBySideDiffTooltip,
+		},
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiffOptionsMenuTitle, Items: menuItems}); err != nil {
+		return err
+	}
+
+	self.c.Contexts().Menu.SetSelectedLineIdx(selectedLineIdx)
+	return self.c.PostRefreshUpdate(self.c.Contexts().Menu)
+}
+
+func (self *DiffOptionsMenuAction) algorithmLabel(algorithm string) string {
+	if algorithm == "" {
+		return self.c.Tr.GitDefault
+	}
+	return algorithm
+}
+
+func (self *DiffOptionsMenuAction) renameThresholdLabel(threshold int) string {
+	if threshold == 0 {
+		return self.c.Tr.GitDefault
+	}
+	return fmt.Sprintf("%d%%", threshold)
+}
+
+func (self *DiffOptionsMenuAction) selectAlgorithm(diffContext types.Context) error {
+	// the patch builder keeps the indices of the lines in the diff, which
+	// another algorithm would shuffle around
+	if self.c.Git().Patch.PatchBuilder.Active() {
+		return self.c.ErrorMsg(self.c.Tr.CantChangeDiffAlgorithmError)
+	}
+
+	menuItems := lo.Map(diffAlgorithms, func(algorithm string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{helpers.Checkbox(self.c.GetAppState().DiffAlgorithm == algorithm) + " " + self.algorithmLabel(algorithm)},
+			OnPress: func() error {
+				self.c.GetAppState().DiffAlgorithm = algorithm
+				return self.apply(diffContext, 0)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiffAlgorithm, Items: menuItems})
+}
+
+func (self *DiffOptionsMenuAction) promptForRenameThreshold(diffContext types.Context) error {
+	initialContent := ""
+	if threshold := self.c.GetAppState().RenameThreshold; threshold > 0 {
+		initialContent = strconv.Itoa(threshold)
+	}
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.RenameThresholdPrompt,
+		InitialContent: initialContent,
+		HandleConfirm: func(response string) error {
+			threshold, err := parseRenameThreshold(response)
+			if err != nil {
+				return self.c.ErrorMsg(self.c.Tr.InvalidRenameThreshold)
+			}
+
+			self.c.GetAppState().RenameThreshold = threshold
+			return self.apply(diffContext, 2)
+		},
+	})
+}
+
+// An empty response goes back to git's default
+func parseRenameThreshold(response string) (int, error) {
+	response = strings.TrimSuffix(strings.TrimSpace(response), "%")
+	if response == "" {
+		return 0, nil
+	}
+
+	threshold, err := strconv.Atoi(response)
+	if err != nil {
+		return 0, err
+	}
+	if threshold < 0 || threshold > 100 {
+		return 0, errors.New("out of range")
+	}
+	return threshold, nil
+}
+
+func (self *DiffOptionsMenuAction) apply(diffContext types.Context, selectedLineIdx int) error {
+	self.c.SaveAppStateAndLogError()
+
+	if err := rerenderDiff(self.c, diffContext); err != nil {
+		return err
+	}
+
+	return self.show(diffContext, selectedLineIdx)
+}
+
+// Shows the diff in the main view again after changing how it's computed. The
+// staging and patch building views build their state from the diff, so they
+// need refreshing rather than just re-rendering.
+func rerenderDiff(c *ControllerCommon, currentContext types.Context) error {
+	switch currentContext.GetKey() {
+	case context.PATCH_BUILDING_MAIN_CONTEXT_KEY:
+		return c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.PATCH_BUILDING}})
+	case context.STAGING_MAIN_CONTEXT_KEY, context.STAGING_SECONDARY_CONTEXT_KEY:
+		return c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	default:
+		return c.CurrentSideContext().HandleRenderToMain()
+	}
+}
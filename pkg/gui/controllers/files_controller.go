@@ -1,8 +1,6 @@
 package controllers
 
 import (
-	"strings"
-
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -95,6 +93,13 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.ViewStashOptions,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewLfsOptions),
+			Handler:     self.openLfsMenu,
+			Description: self.c.Tr.ViewLfsOptions,
+			Tooltip:     self.c.Tr.ViewLfsOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.ToggleStagedAll),
 			Handler:     self.toggleStagedAll,
@@ -207,7 +212,6 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			split := self.c.UserConfig.Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
-			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
 			title := self.c.Tr.UnstagedChanges
 			if mainShowsStaged {
 				title = self.c.Tr.StagedChanges
@@ -215,15 +219,13 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 			refreshOpts := types.RefreshMainOpts{
 				Pair: pair,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     self.diffTask(node, mainShowsStaged),
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
 			}
 
 			if split {
-				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
-
 				title := self.c.Tr.StagedChanges
 				if mainShowsStaged {
 					title = self.c.Tr.UnstagedChanges
@@ -232,7 +234,7 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 				refreshOpts.Secondary = &types.ViewUpdateOpts{
 					Title:    title,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     self.diffTask(node, true),
 				}
 			}
 
@@ -241,6 +243,24 @@ func (self *FilesController) GetOnRenderToMain() func() error {
 	}
 }
 
+// Changes to files that are managed by git-lfs are summed up, because the diff
+// of their pointers says nothing about what changed
+func (self *FilesController) diffTask(node *filetree.FileNode, cached bool) types.UpdateTask {
+	cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, cached)
+	diffTask := types.NewRunPtyDiffTask(cmdObj.GetCmd())
+	if node.File == nil || !node.File.IsLfs() {
+		return diffTask
+	}
+
+	file := node.File
+	return types.NewDeferredTask(func() types.UpdateTask {
+		if change, ok := self.c.Git().Lfs.WorktreeObjectChange(file, cached); ok {
+			return types.NewRenderStringTask(self.c.Helpers().Diff.LfsObjectChangeSummary(change))
+		}
+		return diffTask
+	})
+}
+
 func (self *FilesController) GetOnClick() func() error {
 	return self.checkSelectedFileNode(self.press)
 }
@@ -895,31 +915,32 @@ func (self *FilesController) handleStashSave(stashFunc func(message string) erro
 }
 
 func (self *FilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
-	return self.EnterFile(types.OnFocusOpts{ClickedWindowName: "main", ClickedViewLineIdx: opts.Y})
+	return self.EnterFile(types.OnFocusOpts{
+		ClickedWindowName:      "main",
+		ClickedViewLineIdx:     opts.Y,
+		ClickedViewColumn:      opts.X,
+		ClickedSideBySideWidth: self.c.Helpers().Diff.SideBySideWidth(self.c.Views().Main),
+	})
 }
 
 func (self *FilesController) onClickSecondary(opts gocui.ViewMouseBindingOpts) error {
-	return self.EnterFile(types.OnFocusOpts{ClickedWindowName: "secondary", ClickedViewLineIdx: opts.Y})
-}
-
-func (self *FilesController) fetch() error {
-	return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
-		if err := self.fetchAux(task); err != nil {
-			_ = self.c.Error(err)
-		}
-		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return self.EnterFile(types.OnFocusOpts{
+		ClickedWindowName:      "secondary",
+		ClickedViewLineIdx:     opts.Y,
+		ClickedViewColumn:      opts.X,
+		ClickedSideBySideWidth: self.c.Helpers().Diff.SideBySideWidth(self.c.Views().Secondary),
 	})
 }
 
-func (self *FilesController) fetchAux(task gocui.Task) (err error) {
-	self.c.LogAction("Fetch")
-	err = self.c.Git().Sync.Fetch(task)
-
-	if err != nil && strings.Contains(err.Error(), "exit status 128") {
-		_ = self.c.ErrorMsg(self.c.Tr.PassUnameWrong)
+func (self *FilesController) openLfsMenu() error {
+	selectedPath := ""
+	if file := self.context().GetSelectedFile(); file != nil {
+		selectedPath = file.Name
 	}
 
-	_ = self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
+	return (&LfsMenuAction{c: self.c}).Call(selectedPath)
+}
 
-	return err
+func (self *FilesController) fetch() error {
+	return self.c.Helpers().Fetch.Fetch()
 }
@@ -47,11 +47,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 			{
 				Label: self.c.Tr.DiscardAllChanges,
 				OnPress: func() error {
-					self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInDirectory)
-					if err := self.c.Git().WorkingTree.DiscardAllDirChanges(node); err != nil {
-						return self.c.Error(err)
-					}
-					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+					return self.discard(self.c.Tr.Actions.DiscardAllChangesInDirectory, filePaths(node), func() error {
+						return self.c.Git().WorkingTree.DiscardAllDirChanges(node)
+					})
 				},
 				Key: self.c.KeybindingsOpts().GetKey(self.c.UserConfig.Keybinding.Files.ConfirmDiscard),
 				Tooltip: utils.ResolvePlaceholderString(
@@ -67,12 +65,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 			menuItems = append(menuItems, &types.MenuItem{
 				Label: self.c.Tr.DiscardUnstagedChanges,
 				OnPress: func() error {
-					self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedChangesInDirectory)
-					if err := self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node); err != nil {
-						return self.c.Error(err)
-					}
-
-					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+					return self.discard(self.c.Tr.Actions.DiscardUnstagedChangesInDirectory, filePaths(node), func() error {
+						return self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node)
+					})
 				},
 				Key: 'u',
 				Tooltip: utils.ResolvePlaceholderString(
@@ -103,11 +98,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 				{
 					Label: self.c.Tr.DiscardAllChanges,
 					OnPress: func() error {
-						self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInFile)
-						if err := self.c.Git().WorkingTree.DiscardAllFileChanges(file); err != nil {
-							return self.c.Error(err)
-						}
-						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+						return self.discard(self.c.Tr.Actions.DiscardAllChangesInFile, file.Names(), func() error {
+							return self.c.Git().WorkingTree.DiscardAllFileChanges(file)
+						})
 					},
 					Key: self.c.KeybindingsOpts().GetKey(self.c.UserConfig.Keybinding.Files.ConfirmDiscard),
 					Tooltip: utils.ResolvePlaceholderString(
@@ -123,12 +116,9 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 				menuItems = append(menuItems, &types.MenuItem{
 					Label: self.c.Tr.DiscardUnstagedChanges,
 					OnPress: func() error {
-						self.c.LogAction(self.c.Tr.Actions.DiscardAllUnstagedChangesInFile)
-						if err := self.c.Git().WorkingTree.DiscardUnstagedFileChanges(file); err != nil {
-							return self.c.Error(err)
-						}
-
-						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+						return self.discard(self.c.Tr.Actions.DiscardAllUnstagedChangesInFile, file.Names(), func() error {
+							return self.c.Git().WorkingTree.DiscardUnstagedFileChanges(file)
+						})
 					},
 					Key: 'u',
 					Tooltip: utils.ResolvePlaceholderString(
@@ -145,6 +135,35 @@ func (self *FilesRemoveController) remove(node *filetree.FileNode) error {
 	return self.c.Menu(types.CreateMenuOptions{Title: node.GetPath(), Items: menuItems})
 }
 
+// Snapshots the files so that they can be recovered and the discard can be
+// undone, then discards their changes
+func (self *FilesRemoveController) discard(action string, paths []string, discard func() error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.DiscardingStatus, func(gocui.Task) error {
+		self.c.LogAction(action)
+		snapshotSha, err := self.c.Helpers().DiscardedChanges.Snapshot(action, paths)
+		if err != nil {
+			return err
+		}
+		if err := discard(); err != nil {
+			return err
+		}
+		self.c.Helpers().Journal.RecordDiscard(action, paths, snapshotSha)
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
+	})
+}
+
+// The paths of the files in a directory, including the previous paths of
+// renamed files
+func filePaths(node *filetree.FileNode) []string {
+	paths := []string{}
+	_ = node.ForEachFile(func(file *models.File) error {
+		paths = append(paths, file.Names()...)
+		return nil
+	})
+	return paths
+}
+
 func (self *FilesRemoveController) ResetSubmodule(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.ResettingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.ResetSubmodule)
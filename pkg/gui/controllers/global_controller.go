@@ -75,6 +75,13 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description: self.c.Tr.OpenFilteringMenu,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenCommandPalette),
+			Handler:     self.openCommandPalette,
+			Description: self.c.Tr.OpenCommandPalette,
+			Tooltip:     self.c.Tr.OpenCommandPaletteTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.DiffingMenu),
 			Handler:     self.createDiffingMenu,
@@ -112,6 +119,24 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     self.toggleWhitespace,
 			Description: self.c.Tr.ToggleWhitespaceInDiffView,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.CycleWordDiffInDiffView),
+			Handler:     self.cycleWordDiff,
+			Description: self.c.Tr.CycleWordDiffInDiffView,
+			Tooltip:     self.c.Tr.CycleWordDiffInDiffViewTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.DiffOptionsMenu),
+			Handler:     self.createDiffOptionsMenu,
+			Description: self.c.Tr.OpenDiffOptionsMenu,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.CancelCommand),
+			Handler:     self.cancelCommand,
+			Description: self.c.Tr.CancelCommand,
+			Tooltip:     self.c.Tr.CancelCommandTooltip,
+		},
 	}
 }
 
@@ -143,6 +168,10 @@ func (self *GlobalController) createOptionsMenu() error {
 	return (&OptionsMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) openCommandPalette() error {
+	return (&CommandPaletteAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) createFilteringMenu() error {
 	return (&FilteringMenuAction{c: self.c}).Call()
 }
@@ -151,6 +180,10 @@ func (self *GlobalController) createDiffingMenu() error {
 	return (&DiffingMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) createDiffOptionsMenu() error {
+	return (&DiffOptionsMenuAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) quit() error {
 	return (&QuitActions{c: self.c}).Quit()
 }
@@ -166,3 +199,14 @@ func (self *GlobalController) escape() error {
 func (self *GlobalController) toggleWhitespace() error {
 	return (&ToggleWhitespaceAction{c: self.c}).Call()
 }
+
+func (self *GlobalController) cycleWordDiff() error {
+	return (&CycleWordDiffAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) cancelCommand() error {
+	if !self.c.Helpers().AppStatus.CancelWaitingStatus() && !self.c.Helpers().InlineStatus.CancelInlineStatus() {
+		self.c.Toast(self.c.Tr.NoCommandToCancel)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -43,7 +44,11 @@ type appStatusHelperTask struct {
 }
 
 // poor man's version of explicitly saying that struct X implements interface Y
-var _ gocui.Task = appStatusHelperTask{}
+var (
+	_ gocui.Task                  = appStatusHelperTask{}
+	_ oscommands.ProgressReporter = appStatusHelperTask{}
+	_ oscommands.Cancellable      = appStatusHelperTask{}
+)
 
 func (self appStatusHelperTask) Pause() {
 	self.waitingStatusHandle.Hide()
@@ -55,6 +60,16 @@ func (self appStatusHelperTask) Continue() {
 	self.waitingStatusHandle.Show()
 }
 
+// Shows the progress of the command that the task is running in the status
+func (self appStatusHelperTask) ReportProgress(progress oscommands.Progress) {
+	self.waitingStatusHandle.SetProgress(progress.String())
+}
+
+// Commands run with the task are terminated when the user cancels it
+func (self appStatusHelperTask) Cancelled() <-chan struct{} {
+	return self.waitingStatusHandle.Cancelled()
+}
+
 // withWaitingStatus wraps a function and shows a waiting status while the function is still executing
 func (self *AppStatusHelper) WithWaitingStatus(message string, f func(gocui.Task) error) {
 	self.c.OnWorker(func(task gocui.Task) {
@@ -68,6 +83,12 @@ func (self *AppStatusHelper) WithWaitingStatus(message string, f func(gocui.Task
 	})
 }
 
+// Cancels the operation whose waiting status is shown. Returns false if no
+// operation is running.
+func (self *AppStatusHelper) CancelWaitingStatus() bool {
+	return self.statusMgr().CancelWaitingStatus()
+}
+
 func (self *AppStatusHelper) HasStatus() bool {
 	return self.statusMgr().HasStatus()
 }
@@ -0,0 +1,150 @@
+package helpers
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/auditlog"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+const auditLogTimeFormat = "2006-01-02 15:04:05"
+
+type AuditLogHelper struct {
+	c            *HelperCommon
+	searchHelper *SearchHelper
+}
+
+func NewAuditLogHelper(c *HelperCommon, searchHelper *SearchHelper) *AuditLogHelper {
+	return &AuditLogHelper{
+		c:            c,
+		searchHelper: searchHelper,
+	}
+}
+
+// Opens a menu with the sessions recorded in the audit log, most recent first,
+// preceded by an item for searching the entries of all sessions. The log files
+// can be large, so they're read in the background.
+func (self *AuditLogHelper) OpenSessionsMenu() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingAuditLog, func(gocui.Task) error {
+		path := filepath.Join(self.c.GetConfig().GetUserConfigDir(), auditlog.FileName)
+		entries, err := auditlog.Read(path, self.c.UserConfig.AuditLog.MaxFiles)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(entries) == 0 {
+				return self.c.ErrorMsg(self.c.Tr.AuditLogIsEmpty)
+			}
+
+			return self.showSessionsMenu(entries)
+		})
+		return nil
+	})
+}
+
+func (self *AuditLogHelper) showSessionsMenu(entries []auditlog.Entry) error {
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.SearchAllSessions, "", self.entryCount(entries)},
+			OnPress: func() error {
+				if err := self.openEntriesMenu(self.c.Tr.AllSessions, entries); err != nil {
+					return err
+				}
+				return self.searchHelper.OpenFilterPrompt(self.c.Contexts().Menu)
+			},
+			OpensMenu: true,
+		},
+	}
+
+	for _, session := range auditlog.GroupBySession(entries) {
+		session := session
+		title := session.Start().Format(auditLogTimeFormat)
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				title,
+				strings.Join(lo.Map(session.RepoPaths(), func(path string, _ int) string {
+					return utils.TruncateWithEllipsis(path, 60)
+				}), ", "),
+				self.entryCount(session.Entries),
+			},
+			OnPress: func() error {
+				return self.openEntriesMenu(title, session.Entries)
+			},
+			OpensMenu: true,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.AuditLogTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *AuditLogHelper) entryCount(entries []auditlog.Entry) string {
+	return style.FgBlue.Sprintf(self.c.Tr.AuditLogEntryCount, len(entries))
+}
+
+// Opens a menu with the given entries, most recent action first, each followed
+// by its commands. Pressing a command copies it to the clipboard, so that it
+// can be run again.
+func (self *AuditLogHelper) openEntriesMenu(title string, entries []auditlog.Entry) error {
+	menuItems := make([]*types.MenuItem, 0, len(entries))
+	for _, entry := range auditlog.NewestActionsFirst(entries) {
+		entry := entry
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				entry.Time.Format(auditLogTimeFormat),
+				self.entryText(entry),
+				self.entryResult(entry),
+			},
+			OnPress: func() error {
+				if !entry.IsCommand() {
+					return nil
+				}
+
+				if err := self.c.OS().CopyToClipboard(entry.Text); err != nil {
+					return self.c.Error(err)
+				}
+
+				self.c.Toast(self.c.Tr.CommandCopiedToClipboard)
+				return nil
+			},
+			Tooltip: fmt.Sprintf(self.c.Tr.AuditLogEntryTooltip, entry.RepoPath),
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: menuItems,
+	})
+}
+
+// Actions are shown like in the command log, with the commands that follow
+// them indented below them
+func (self *AuditLogHelper) entryText(entry auditlog.Entry) string {
+	if !entry.IsCommand() {
+		return style.FgYellow.Sprint(entry.Text)
+	}
+
+	return "  " + strings.ReplaceAll(entry.Text, "\n", " ")
+}
+
+func (self *AuditLogHelper) entryResult(entry auditlog.Entry) string {
+	if !entry.IsCommand() || entry.ExitCode == nil {
+		return ""
+	}
+
+	textStyle := style.FgGreen
+	if *entry.ExitCode != 0 {
+		textStyle = style.FgRed
+	}
+
+	return textStyle.Sprintf(self.c.Tr.AuditLogCommandResult, *entry.ExitCode, entry.Duration())
+}
@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// A checklist is a menu in which the user can toggle any number of items before
+// confirming. Used for bulk actions like deleting several branches at once.
+type ChecklistHelper struct {
+	c *HelperCommon
+}
+
+func NewChecklistHelper(c *HelperCommon) *ChecklistHelper {
+	return &ChecklistHelper{
+		c: c,
+	}
+}
+
+type ChecklistItem struct {
+	Label string
+	// Optional extra column shown after the label
+	Description string
+	Checked     bool
+	// If non-empty, the item can't be checked and this is shown in its tooltip
+	DisabledReason string
+}
+
+type ChecklistOpts struct {
+	Title        string
+	Items        []*ChecklistItem
+	ConfirmLabel string
+	// Called with the checked items once the user confirms
+	HandleConfirm func(checked []*ChecklistItem) error
+}
+
+func (self *ChecklistHelper) Show(opts ChecklistOpts) error {
+	return self.show(opts, 0)
+}
+
+// Pressing a menu item closes the menu, so toggling an item re-opens the menu
+// with the same line selected.
+func (self *ChecklistHelper) show(opts ChecklistOpts, selectedLineIdx int) error {
+	checkedItems := lo.Filter(opts.Items, func(item *ChecklistItem, _ int) bool {
+		return item.Checked
+	})
+
+	confirmItem := &types.MenuItem{
+		LabelColumns: []string{style.FgGreen.Sprintf("%s (%d)", opts.ConfirmLabel, len(checkedItems))},
+		OnPress: func() error {
+			return opts.HandleConfirm(checkedItems)
+		},
+		Key: 'c',
+	}
+	if len(checkedItems) == 0 {
+		confirmItem.DisabledReason = self.c.Tr.NoItemsSelected
+	}
+
+	toggleAllItem := &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.ToggleAll},
+		OnPress: func() error {
+			enabledItems := lo.Filter(opts.Items, func(item *ChecklistItem, _ int) bool {
+				return item.DisabledReason == ""
+			})
+			allChecked := lo.EveryBy(enabledItems, func(item *ChecklistItem) bool {
+				return item.Checked
+			})
+			for _, item := range enabledItems {
+				item.Checked = !allChecked
+			}
+			return self.show(opts, 1)
+		},
+		Key: 'a',
+	}
+
+	menuItems := []*types.MenuItem{confirmItem, toggleAllItem}
+	headerCount := len(menuItems)
+	for i, item := range opts.Items {
+		i := i
+		item := item
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{Checkbox(item.Checked) + " " + item.Label, item.Description},
+			OnPress: func() error {
+				item.Checked = !item.Checked
+				return self.show(opts, headerCount+i)
+			},
+			DisabledReason: item.DisabledReason,
+		})
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{Title: opts.Title, Items: menuItems}); err != nil {
+		return err
+	}
+
+	self.c.Contexts().Menu.SetSelectedLineIdx(selectedLineIdx)
+	return self.c.PostRefreshUpdate(self.c.Contexts().Menu)
+}
+
+// Renders a checkbox for menu items that can be toggled
+func Checkbox(checked bool) string {
+	if checked {
+		return fmt.Sprintf("[%s]", style.FgGreen.Sprint("x"))
+	}
+	return "[ ]"
+}
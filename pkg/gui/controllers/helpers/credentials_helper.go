@@ -7,6 +7,12 @@ import (
 
 type CredentialsHelper struct {
 	c *HelperCommon
+
+	// Commands running at the same time (e.g. when fetching several remotes)
+	// can ask for credentials at the same time, but a prompt would replace the
+	// one that's already showing, so they take turns. Only accessed on the UI
+	// thread.
+	queuedPrompts []func() error
 }
 
 func NewCredentialsHelper(
@@ -25,27 +31,47 @@ func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.Cr
 	ch := make(chan string)
 
 	self.c.OnUIThread(func() error {
-		title, mask := self.getTitleAndMask(passOrUname)
-
-		return self.c.Prompt(types.PromptOpts{
-			Title: title,
-			Mask:  mask,
-			HandleConfirm: func(input string) error {
-				ch <- input + "\n"
-
-				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
-			},
-			HandleClose: func() error {
-				ch <- "\n"
-
-				return nil
-			},
+		self.queuedPrompts = append(self.queuedPrompts, func() error {
+			return self.prompt(passOrUname, ch)
 		})
+		if len(self.queuedPrompts) == 1 {
+			return self.queuedPrompts[0]()
+		}
+		return nil
 	})
 
 	return ch
 }
 
+func (self *CredentialsHelper) prompt(passOrUname oscommands.CredentialType, ch chan<- string) error {
+	respond := func(response string) error {
+		ch <- response
+
+		self.queuedPrompts = self.queuedPrompts[1:]
+		if len(self.queuedPrompts) > 0 {
+			return self.queuedPrompts[0]()
+		}
+		return nil
+	}
+
+	title, mask := self.getTitleAndMask(passOrUname)
+
+	return self.c.Prompt(types.PromptOpts{
+		Title: title,
+		Mask:  mask,
+		HandleConfirm: func(input string) error {
+			if err := respond(input + "\n"); err != nil {
+				return err
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		},
+		HandleClose: func() error {
+			return respond("\n")
+		},
+	})
+}
+
 func (self *CredentialsHelper) getTitleAndMask(passOrUname oscommands.CredentialType) (string, bool) {
 	switch passOrUname {
 	case oscommands.Username:
@@ -1,9 +1,13 @@
 package helpers
 
 import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -45,6 +49,63 @@ func (self *DiffHelper) DiffArgs() []string {
 	return output
 }
 
+// Whether diffs in the main views are rendered side by side by us. Pagers and
+// external diff tools render diffs themselves, so we leave it to them.
+func (self *DiffHelper) ShowingSideBySide() bool {
+	pagingConfig := self.c.UserConfig.Git.Paging
+	return self.c.GetAppState().SideBySideDiff &&
+		pagingConfig.Pager == "" && !pagingConfig.UseConfig && pagingConfig.ExternalDiffCommand == ""
+}
+
+// Returns how the changes within the lines of diffs in the main views are
+// highlighted by us. Pagers and external diff tools render diffs themselves,
+// so we leave it to them.
+func (self *DiffHelper) WordDiffMode() patch.WordDiffMode {
+	pagingConfig := self.c.UserConfig.Git.Paging
+	if pagingConfig.Pager != "" || pagingConfig.UseConfig || pagingConfig.ExternalDiffCommand != "" {
+		return patch.WordDiffOff
+	}
+	return patch.WordDiffMode(self.c.GetAppState().WordDiffMode)
+}
+
+// Returns the width that the diff shown in the given view is laid out for if
+// it's shown side by side, or 0 if it isn't
+func (self *DiffHelper) SideBySideWidth(view *gocui.View) int {
+	if !self.ShowingSideBySide() {
+		return 0
+	}
+	return view.InnerWidth()
+}
+
+// Returns the index of the line of the given diff that was clicked to focus a
+// view. That's the clicked row, unless the diff was shown side by side, where a
+// row can stand for both a removed and an added line, depending on the half
+// that was clicked.
+func clickedLineIdx(opts types.OnFocusOpts, diff string) int {
+	if opts.ClickedSideBySideWidth == 0 {
+		return opts.ClickedViewLineIdx
+	}
+	return patch.SideBySideLineIdx(diff, opts.ClickedSideBySideWidth, opts.ClickedViewLineIdx, opts.ClickedViewColumn)
+}
+
+// Returns what's shown instead of the diff of a file that's managed by git-lfs
+func (self *DiffHelper) LfsObjectChangeSummary(change git_commands.LfsObjectChange) string {
+	if change.OldSize == -1 {
+		return utils.ResolvePlaceholderString(self.c.Tr.LfsObjectAdded, map[string]string{
+			"size": utils.FormatFileSize(change.NewSize),
+		})
+	}
+	if change.NewSize == -1 {
+		return utils.ResolvePlaceholderString(self.c.Tr.LfsObjectDeleted, map[string]string{
+			"size": utils.FormatFileSize(change.OldSize),
+		})
+	}
+	return utils.ResolvePlaceholderString(self.c.Tr.LfsObjectChanged, map[string]string{
+		"oldSize": utils.FormatFileSize(change.OldSize),
+		"newSize": utils.FormatFileSize(change.NewSize),
+	})
+}
+
 func (self *DiffHelper) ExitDiffMode() error {
 	self.c.Modes().Diffing = diffing.New()
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
@@ -52,7 +113,7 @@ func (self *DiffHelper) ExitDiffMode() error {
 
 func (self *DiffHelper) RenderDiff() error {
 	cmdObj := self.c.Git().Diff.DiffCmdObj(self.DiffArgs())
-	task := types.NewRunPtyTask(cmdObj.GetCmd())
+	task := types.NewRunPtyDiffTask(cmdObj.GetCmd())
 
 	return self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().Normal,
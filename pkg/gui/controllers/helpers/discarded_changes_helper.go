@@ -0,0 +1,162 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Older snapshots are dropped; git eventually garbage collects them anyway
+const maxDiscardedChangesSnapshots = 50
+
+// Takes snapshots of the files in the working tree before discarding their
+// changes, and lets the user restore files from them afterwards. The
+// snapshots are indexed in the app state so that they survive restarts.
+type DiscardedChangesHelper struct {
+	c *HelperCommon
+}
+
+func NewDiscardedChangesHelper(c *HelperCommon) *DiscardedChangesHelper {
+	return &DiscardedChangesHelper{
+		c: c,
+	}
+}
+
+// Stores the current content of the given files, including untracked ones,
+// and returns the sha of the snapshot. Does nothing if no paths are passed.
+func (self *DiscardedChangesHelper) Snapshot(action string, paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+
+	description := fmt.Sprintf("%s: %s", action, utils.TruncateWithEllipsis(strings.Join(paths, ", "), 60))
+	return self.snapshot(description, paths)
+}
+
+// Stores the current content of all files in the working tree
+func (self *DiscardedChangesHelper) SnapshotAll(action string) error {
+	_, err := self.snapshot(action, nil)
+	return err
+}
+
+func (self *DiscardedChangesHelper) snapshot(description string, paths []string) (string, error) {
+	sha, err := self.c.Git().WorkingTree.SnapshotChanges(paths, description)
+	if err != nil || sha == "" {
+		return "", err
+	}
+
+	snapshots := append(self.c.GetAppState().DiscardedChanges, config.DiscardedChangesSnapshot{
+		RepoPath:      self.c.Git().RepoPaths.WorktreePath(),
+		Sha:           sha,
+		Description:   description,
+		UnixTimestamp: time.Now().Unix(),
+	})
+	if len(snapshots) > maxDiscardedChangesSnapshots {
+		snapshots = snapshots[len(snapshots)-maxDiscardedChangesSnapshots:]
+	}
+	self.c.GetAppState().DiscardedChanges = snapshots
+	self.c.SaveAppStateAndLogError()
+	return sha, nil
+}
+
+// Opens a menu with the snapshots of the current worktree, most recent first
+func (self *DiscardedChangesHelper) OpenRecoveryMenu() error {
+	worktreePath := self.c.Git().RepoPaths.WorktreePath()
+	snapshots := lo.Filter(self.c.GetAppState().DiscardedChanges, func(snapshot config.DiscardedChangesSnapshot, _ int) bool {
+		return snapshot.RepoPath == worktreePath
+	})
+	if len(snapshots) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoDiscardedChanges)
+	}
+
+	menuItems := make([]*types.MenuItem, 0, len(snapshots))
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		snapshot := snapshots[i]
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				style.FgBlue.Sprint(utils.UnixToTimeAgo(snapshot.UnixTimestamp)),
+				snapshot.Description,
+			},
+			OnPress: func() error {
+				return self.openSnapshotMenu(snapshot)
+			},
+			OpensMenu: true,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RecoverDiscardedChanges,
+		Items: menuItems,
+	})
+}
+
+// Opens a menu with the files stored in a snapshot, preceded by an item for
+// restoring all of them
+func (self *DiscardedChangesHelper) openSnapshotMenu(snapshot config.DiscardedChangesSnapshot) error {
+	files, err := self.c.Git().WorkingTree.SnapshotFiles(snapshot.Sha)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.RestoreAllFiles, ""},
+			OnPress: func() error {
+				return self.restore(snapshot, files)
+			},
+		},
+	}
+
+	for _, file := range files {
+		file := file
+		status := ""
+		if file.Deleted {
+			status = style.FgRed.Sprint(self.c.Tr.DeletedFile)
+		}
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{file.Name, status},
+			OnPress: func() error {
+				return self.restore(snapshot, []*git_commands.SnapshotFile{file})
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: snapshot.Description,
+		Items: menuItems,
+	})
+}
+
+// Overwrites the files in the working tree with their content in the
+// snapshot. Their current changes are snapshotted first, because restoring
+// discards them.
+func (self *DiscardedChangesHelper) restore(snapshot config.DiscardedChangesSnapshot, files []*git_commands.SnapshotFile) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RestoringStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.RecoverDiscardedChanges)
+
+		changedPaths := lo.FlatMap(self.c.Model().Files, func(file *models.File, _ int) []string { return file.Names() })
+		paths := lo.Filter(
+			lo.Map(files, func(file *git_commands.SnapshotFile, _ int) string { return file.Name }),
+			func(path string, _ int) bool { return lo.Contains(changedPaths, path) },
+		)
+		if _, err := self.Snapshot(self.c.Tr.Actions.RecoverDiscardedChanges, paths); err != nil {
+			return err
+		}
+
+		names := lo.Map(files, func(file *git_commands.SnapshotFile, _ int) string { return file.Name })
+		if err := self.c.Git().WorkingTree.RestoreFilesFromSnapshot(snapshot.Sha, names); err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	})
+}
@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Fetches remotes, showing their progress in the status bar, and afterwards
+// shows a summary of the remote branches and tags that changed
+type FetchHelper struct {
+	c          *HelperCommon
+	refsHelper *RefsHelper
+}
+
+func NewFetchHelper(c *HelperCommon, refsHelper *RefsHelper) *FetchHelper {
+	return &FetchHelper{
+		c:          c,
+		refsHelper: refsHelper,
+	}
+}
+
+// Fetches the remotes that 'git fetch --all' would fetch at the same time if
+// git.fetchAll is on, otherwise the remote that git fetch would fetch
+func (self *FetchHelper) Fetch() error {
+	return self.FetchRemotes(self.c.Tr.FetchingStatus, self.remotesToFetch())
+}
+
+func (self *FetchHelper) remotesToFetch() []string {
+	remoteNames := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) string {
+		return remote.Name
+	})
+	if self.c.UserConfig.Git.FetchAll {
+		return lo.Reject(remoteNames, func(remoteName string, _ int) bool {
+			return self.c.Git().Config.GetRemoteSkipFetchAll(remoteName)
+		})
+	}
+	if len(remoteNames) == 0 {
+		return remoteNames
+	}
+
+	if branch := self.refsHelper.GetCheckedOutRef(); branch != nil && branch.IsTrackingRemote() {
+		return []string{branch.UpstreamRemote}
+	}
+	if lo.Contains(remoteNames, "origin") {
+		return []string{"origin"}
+	}
+	return remoteNames[:1]
+}
+
+func (self *FetchHelper) FetchRemotes(status string, remoteNames []string) error {
+	return self.c.WithWaitingStatus(status, func(task gocui.Task) error {
+		self.c.LogAction("Fetch")
+		results := self.c.Git().Sync.FetchRemotes(task, remoteNames)
+
+		if err := self.c.Refresh(types.RefreshOptions{
+			Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS},
+			Mode:  types.ASYNC,
+		}); err != nil {
+			return err
+		}
+
+		// the summary of a cancelled fetch would only be confusing
+		for _, result := range results {
+			var cancelledErr *oscommands.CancelledError
+			if errors.As(result.Err, &cancelledErr) {
+				return result.Err
+			}
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showResults(results)
+		})
+		return nil
+	})
+}
+
+func (self *FetchHelper) showResults(results []*git_commands.FetchResult) error {
+	failedResults := lo.Filter(results, func(result *git_commands.FetchResult, _ int) bool {
+		return result.Err != nil
+	})
+
+	if !self.c.UserConfig.Git.ShowFetchSummary {
+		if len(failedResults) == 0 {
+			return nil
+		}
+		return self.c.ErrorMsg(strings.Join(lo.Map(failedResults, func(result *git_commands.FetchResult, _ int) string {
+			return result.Remote + ": " + self.errorMessage(result.Err)
+		}), "\n"))
+	}
+
+	if len(failedResults) == 0 && lo.EveryBy(results, func(result *git_commands.FetchResult) bool {
+		return len(result.Updates) == 0
+	}) {
+		self.c.Toast(self.c.Tr.FetchNothingChanged)
+		return nil
+	}
+
+	menuItems := []*types.MenuItem{}
+	for _, result := range results {
+		section := &types.MenuSection{Title: result.Remote}
+
+		if result.Err != nil {
+			message := self.errorMessage(result.Err)
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{style.FgRed.Sprint("!"), style.FgRed.Sprint(self.c.Tr.FetchFailed), strings.Split(message, "\n")[0]},
+				OnPress:      func() error { return nil },
+				Tooltip:      message,
+				Section:      section,
+			})
+			continue
+		}
+
+		if len(result.Updates) == 0 {
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{"", style.FgDefault.Sprint(self.c.Tr.FetchNothingChanged), ""},
+				OnPress:      func() error { return nil },
+				Section:      section,
+			})
+			continue
+		}
+
+		for _, update := range result.Updates {
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: presentation.GetRefUpdateDisplayStrings(update),
+				OnPress:      func() error { return nil },
+				Section:      section,
+			})
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.FetchSummaryTitle, Items: menuItems})
+}
+
+func (self *FetchHelper) errorMessage(err error) string {
+	if strings.Contains(err.Error(), "exit status 128") {
+		return self.c.Tr.PassUnameWrong
+	}
+	return strings.TrimSpace(err.Error())
+}
@@ -23,7 +23,22 @@ func NewGpgHelper(c *HelperCommon) *GpgHelper {
 // fix this bug, or just stop running subprocesses from within there, given that
 // we don't need to see a loading status if we're in a subprocess.
 func (self *GpgHelper) WithGpgHandling(cmdObj oscommands.ICmdObj, waitingStatus string, onSuccess func() error) error {
-	useSubprocess := self.c.Git().Config.UsingGpg()
+	return self.withGpgHandling(self.c.Git().Config.UsingGpg(), cmdObj, waitingStatus, onSuccess)
+}
+
+// Like WithGpgHandling, but for commands that always sign (e.g. `git tag
+// --sign`), regardless of whether the user has commit.gpgSign enabled
+func (self *GpgHelper) WithExplicitSigning(cmdObj oscommands.ICmdObj, waitingStatus string, onSuccess func() error) error {
+	return self.withGpgHandling(!self.c.UserConfig.Git.OverrideGpg, cmdObj, waitingStatus, onSuccess)
+}
+
+func (self *GpgHelper) withGpgHandling(useSubprocess bool, cmdObj oscommands.ICmdObj, waitingStatus string, onSuccess func() error) error {
+	// No need to hand over the terminal if signing won't prompt for a passphrase,
+	// e.g. when signing with an SSH key held by ssh-agent
+	if useSubprocess && self.c.Git().Signing.CanSignWithoutPrompt() {
+		useSubprocess = false
+	}
+
 	if useSubprocess {
 		success, err := self.c.RunSubprocess(cmdObj)
 		if success && onSuccess != nil {
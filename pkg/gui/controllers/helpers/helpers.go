@@ -51,6 +51,13 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Checklist         *ChecklistHelper
+	Theme             *ThemeHelper
+	AuditLog          *AuditLogHelper
+	Fetch             *FetchHelper
+	Journal           *JournalHelper
+	DiscardedChanges  *DiscardedChangesHelper
+	Keybindings       *KeybindingsHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -82,10 +89,17 @@ func NewStubHelpers() *Helpers {
 		Confirmation:      &ConfirmationHelper{},
 		Mode:              &ModeHelper{},
 		AppStatus:         &AppStatusHelper{},
+		Theme:             &ThemeHelper{},
+		AuditLog:          &AuditLogHelper{},
+		Fetch:             &FetchHelper{},
+		Journal:           &JournalHelper{},
+		DiscardedChanges:  &DiscardedChangesHelper{},
+		Keybindings:       &KeybindingsHelper{},
 		InlineStatus:      &InlineStatusHelper{},
 		WindowArrangement: &WindowArrangementHelper{},
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Checklist:         &ChecklistHelper{},
 	}
 }
@@ -1,11 +1,14 @@
 package helpers
 
 import (
+	"sync"
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/sasha-s/go-deadlock"
 )
 
@@ -14,6 +17,9 @@ type InlineStatusHelper struct {
 
 	contextsWithInlineStatus map[types.ContextKey]*inlineStatusInfo
 	mutex                    *deadlock.Mutex
+
+	// the operations that are running, most recently started last
+	runningTasks []*inlineStatusCancellation
 }
 
 func NewInlineStatusHelper(c *HelperCommon) *InlineStatusHelper {
@@ -35,6 +41,15 @@ type inlineStatusInfo struct {
 	stop     chan struct{}
 }
 
+type inlineStatusCancellation struct {
+	cancelled chan struct{}
+	once      sync.Once
+}
+
+func (self *inlineStatusCancellation) cancel() {
+	self.once.Do(func() { close(self.cancelled) })
+}
+
 // A custom task for WithInlineStatus calls; it wraps the original one and
 // hides the status whenever the task is paused, and shows it again when
 // continued.
@@ -43,10 +58,14 @@ type inlineStatusHelperTask struct {
 
 	inlineStatusHelper *InlineStatusHelper
 	opts               InlineStatusOpts
+	cancellation       *inlineStatusCancellation
 }
 
 // poor man's version of explicitly saying that struct X implements interface Y
-var _ gocui.Task = inlineStatusHelperTask{}
+var (
+	_ gocui.Task             = inlineStatusHelperTask{}
+	_ oscommands.Cancellable = inlineStatusHelperTask{}
+)
 
 func (self inlineStatusHelperTask) Pause() {
 	self.inlineStatusHelper.stop(self.opts)
@@ -60,21 +79,58 @@ func (self inlineStatusHelperTask) Continue() {
 	self.inlineStatusHelper.start(self.opts)
 }
 
+// Commands run with the task are terminated when the user cancels it
+func (self inlineStatusHelperTask) Cancelled() <-chan struct{} {
+	return self.cancellation.cancelled
+}
+
 func (self *InlineStatusHelper) WithInlineStatus(opts InlineStatusOpts, f func(gocui.Task) error) {
 	self.c.OnWorker(func(task gocui.Task) {
 		self.start(opts)
+		cancellation := self.addRunningTask()
 
-		err := f(inlineStatusHelperTask{task, self, opts})
+		err := f(inlineStatusHelperTask{task, self, opts, cancellation})
 		if err != nil {
 			self.c.OnUIThread(func() error {
 				return self.c.Error(err)
 			})
 		}
 
+		self.removeRunningTask(cancellation)
 		self.stop(opts)
 	})
 }
 
+// Cancels the operation with an inline status that was started last. Returns
+// false if no such operation is running.
+func (self *InlineStatusHelper) CancelInlineStatus() bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if len(self.runningTasks) == 0 {
+		return false
+	}
+
+	self.runningTasks[len(self.runningTasks)-1].cancel()
+	return true
+}
+
+func (self *InlineStatusHelper) addRunningTask() *inlineStatusCancellation {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	cancellation := &inlineStatusCancellation{cancelled: make(chan struct{})}
+	self.runningTasks = append(self.runningTasks, cancellation)
+	return cancellation
+}
+
+func (self *InlineStatusHelper) removeRunningTask(cancellation *inlineStatusCancellation) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.runningTasks = lo.Without(self.runningTasks, cancellation)
+}
+
 func (self *InlineStatusHelper) start(opts InlineStatusOpts) {
 	self.c.State().SetItemOperation(opts.Item, opts.Operation)
 
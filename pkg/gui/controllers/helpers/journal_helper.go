@@ -0,0 +1,155 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/journal"
+	"github.com/samber/lo"
+)
+
+// Records operations that can't be undone via the reflog in the journal of
+// the current repo, together with what it takes to undo and redo them
+type JournalHelper struct {
+	c *HelperCommon
+}
+
+func NewJournalHelper(c *HelperCommon) *JournalHelper {
+	return &JournalHelper{
+		c: c,
+	}
+}
+
+func (self *JournalHelper) Journal() *journal.Journal {
+	return self.c.State().GetRepoState().GetJournal()
+}
+
+func (self *JournalHelper) record(action string, subjects []string, undo func() error, redo func() error) {
+	var reflogHead *models.Commit
+	if len(self.c.Model().ReflogCommits) > 0 {
+		reflogHead = self.c.Model().ReflogCommits[0]
+	}
+	self.Journal().Record(&journal.Entry{
+		ReflogHead:  reflogHead,
+		Description: fmt.Sprintf("%s: %s", action, strings.Join(subjects, ", ")),
+		Undo:        undo,
+		Redo:        redo,
+	})
+}
+
+// Undoing recreates the branches at the commits they pointed to
+func (self *JournalHelper) RecordBranchDeletion(branches []*models.Branch) {
+	branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+
+	self.record(self.c.Tr.Actions.DeleteLocalBranch, branchNames,
+		func() error {
+			for _, branch := range branches {
+				if err := self.c.Git().Branch.NewWithoutCheckout(branch.Name, branch.CommitHash); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			return self.c.Git().Branch.LocalDeleteMultiple(branchNames, true)
+		},
+	)
+}
+
+// Returns the objects that the given tags point to, to be passed to
+// RecordTagDeletion once they're deleted
+func (self *JournalHelper) TagObjectShas(tagNames []string) []string {
+	return lo.Map(tagNames, func(tagName string, _ int) string {
+		sha, err := self.c.Git().Tag.ObjectSha(tagName)
+		if err != nil {
+			self.c.Log.Error(err)
+		}
+		return sha
+	})
+}
+
+func (self *JournalHelper) RecordTagDeletion(tagNames []string, objectShas []string) {
+	if lo.Contains(objectShas, "") {
+		return
+	}
+
+	self.record(self.c.Tr.Actions.DeleteLocalTag, tagNames,
+		func() error {
+			for i, tagName := range tagNames {
+				if err := self.c.Git().Tag.Restore(tagName, objectShas[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			return self.c.Git().Tag.LocalDeleteMultiple(tagNames)
+		},
+	)
+}
+
+// Undoing stores the dropped commit as the newest stash entry again
+func (self *JournalHelper) RecordStashDrop(sha string, message string) {
+	self.record(self.c.Tr.StashDrop, []string{message},
+		func() error {
+			return self.c.Git().Stash.Store(sha, message)
+		},
+		func() error {
+			// the entry may have moved since it was restored
+			for _, stashEntry := range self.c.Model().StashEntries {
+				if entrySha, err := self.c.Git().Stash.Sha(stashEntry.Index); err == nil && entrySha == sha {
+					return self.c.Git().Stash.Drop(stashEntry.Index)
+				}
+			}
+			return nil
+		},
+	)
+}
+
+func (self *JournalHelper) RecordRemoteUrlChange(remoteName string, oldUrl string, newUrl string) {
+	if oldUrl == newUrl {
+		return
+	}
+
+	self.record(self.c.Tr.Actions.UpdateRemote, []string{remoteName},
+		func() error {
+			return self.c.Git().Remote.UpdateRemoteUrl(remoteName, oldUrl)
+		},
+		func() error {
+			return self.c.Git().Remote.UpdateRemoteUrl(remoteName, newUrl)
+		},
+	)
+}
+
+// Undoing writes the content that the files had in the snapshot taken before
+// the discard back to the working tree, after snapshotting their current
+// content for redoing. Changes that were staged come back as unstaged changes.
+func (self *JournalHelper) RecordDiscard(action string, paths []string, snapshotSha string) {
+	if snapshotSha == "" {
+		return
+	}
+
+	paths = lo.Uniq(paths)
+	sort.Strings(paths)
+	var redoSha string
+	self.record(action, paths,
+		func() error {
+			sha, err := self.c.Git().WorkingTree.SnapshotChanges(paths, action)
+			if err != nil {
+				return err
+			}
+			if sha == "" {
+				// the files are as they are in HEAD, which is where HEAD will
+				// be again when redoing, because undo and redo follow the reflog
+				sha = "HEAD"
+			}
+			redoSha = sha
+			return self.c.Git().WorkingTree.RestoreFilesFromSnapshot(snapshotSha, paths)
+		},
+		func() error {
+			return self.c.Git().WorkingTree.RestoreFilesFromSnapshot(redoSha, paths)
+		},
+	)
+}
@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Building all keybindings (including custom commands and plugins) is not
+// cheap, and finding the conflicts means doing it a second time for the
+// default config, so we only do it once and keep the result until the
+// keybindings change, i.e. when switching repos (which recreates the helpers)
+// or when plugins register theirs. The handlers look up the selection when
+// called, so keeping the bindings around is safe.
+type KeybindingsHelper struct {
+	c *HelperCommon
+
+	bindings  []*types.Binding
+	conflicts []*keybindings.Conflict
+	loaded    bool
+}
+
+func NewKeybindingsHelper(c *HelperCommon) *KeybindingsHelper {
+	return &KeybindingsHelper{
+		c: c,
+	}
+}
+
+func (self *KeybindingsHelper) Bindings() []*types.Binding {
+	self.load()
+
+	return self.bindings
+}
+
+func (self *KeybindingsHelper) Conflicts() []*keybindings.Conflict {
+	self.load()
+
+	return self.conflicts
+}
+
+func (self *KeybindingsHelper) Invalidate() {
+	self.bindings = nil
+	self.conflicts = nil
+	self.loaded = false
+}
+
+func (self *KeybindingsHelper) load() {
+	if self.loaded {
+		return
+	}
+
+	self.bindings, _ = self.c.GetInitialKeybindingsWithCustomCommands()
+	self.conflicts = keybindings.FindConflicts(self.bindings, self.c.GetDefaultKeybindings())
+	self.loaded = true
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -100,11 +101,14 @@ func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 			self.c.Git().Rebase.GenericMergeOrRebaseActionCmdObj(commandType, command),
 		)
 	}
-	result := self.c.Git().Rebase.GenericMergeOrRebaseAction(commandType, command)
-	if err := self.CheckMergeOrRebase(result); err != nil {
-		return err
+	waitingStatus := self.c.Tr.RebasingStatus
+	if status == enums.REBASE_MODE_MERGING {
+		waitingStatus = self.c.Tr.MergingStatus
 	}
-	return nil
+	return self.c.WithWaitingStatus(waitingStatus, func(task gocui.Task) error {
+		result := self.c.Git().Rebase.GenericMergeOrRebaseAction(task, commandType, command)
+		return self.CheckMergeOrRebase(result)
+	})
 }
 
 var conflictStrings = []string{
@@ -155,30 +159,68 @@ func (self *MergeAndRebaseHelper) CheckForConflicts(result error) error {
 	if isMergeConflictErr(result.Error()) {
 		return self.PromptForConflictHandling()
 	} else {
-		return self.c.ErrorMsg(result.Error())
+		return self.c.Error(result)
 	}
 }
 
 func (self *MergeAndRebaseHelper) PromptForConflictHandling() error {
+	return self.promptForConflictHandling(nil)
+}
+
+// Like CheckMergeOrRebase, but for the result of pulling the given branch. In
+// case of conflicts, the conflicts menu also lists the incoming commits, so
+// that you know what you're resolving the conflicts with.
+func (self *MergeAndRebaseHelper) CheckPullResult(result error, branchName string) error {
+	if result == nil || !isMergeConflictErr(result.Error()) {
+		return self.CheckMergeOrRebase(result)
+	}
+
+	if err := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
+		return err
+	}
+
+	incomingCommits, err := self.c.Git().Branch.GetIncomingCommits(branchName)
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	return self.promptForConflictHandling(incomingCommits)
+}
+
+func (self *MergeAndRebaseHelper) promptForConflictHandling(incomingCommits []string) error {
 	mode := self.workingTreeStateNoun()
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: self.c.Tr.FoundConflictsTitle,
-		Items: []*types.MenuItem{
-			{
-				Label: self.c.Tr.ViewConflictsMenuItem,
-				OnPress: func() error {
-					return self.c.PushContext(self.c.Contexts().Files)
-				},
-				Key: 'v',
-			},
-			{
-				Label: fmt.Sprintf(self.c.Tr.AbortMenuItem, mode),
-				OnPress: func() error {
-					return self.genericMergeCommand(REBASE_OPTION_ABORT)
-				},
-				Key: 'a',
+	viewConflicts := func() error {
+		return self.c.PushContext(self.c.Contexts().Files)
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.ViewConflictsMenuItem,
+			OnPress: viewConflicts,
+			Key:     'v',
+		},
+		{
+			Label: fmt.Sprintf(self.c.Tr.AbortMenuItem, mode),
+			OnPress: func() error {
+				return self.genericMergeCommand(REBASE_OPTION_ABORT)
 			},
+			Key: 'a',
 		},
+	}
+
+	section := &types.MenuSection{Title: fmt.Sprintf(self.c.Tr.IncomingCommits, len(incomingCommits))}
+	for _, commit := range incomingCommits {
+		sha, subject, _ := strings.Cut(commit, " ")
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{style.FgYellow.Sprint(sha), subject},
+			OnPress:      viewConflicts,
+			Section:      section,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:      self.c.Tr.FoundConflictsTitle,
+		Items:      menuItems,
 		HideCancel: true,
 	})
 }
@@ -230,9 +272,9 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 					baseCommit := self.c.Modes().MarkedBaseCommit.GetSha()
 					var err error
 					if baseCommit != "" {
-						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, baseCommit)
+						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(task, ref, baseCommit)
 					} else {
-						err = self.c.Git().Rebase.RebaseBranch(ref)
+						err = self.c.Git().Rebase.RebaseBranch(task, ref)
 					}
 					err = self.CheckMergeOrRebase(err)
 					if err == nil {
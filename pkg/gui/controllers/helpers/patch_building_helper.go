@@ -55,11 +55,6 @@ func (self *PatchBuildingHelper) Reset() error {
 }
 
 func (self *PatchBuildingHelper) RefreshPatchBuildingPanel(opts types.OnFocusOpts) error {
-	selectedLineIdx := -1
-	if opts.ClickedWindowName == "main" {
-		selectedLineIdx = opts.ClickedViewLineIdx
-	}
-
 	if !self.c.Git().Patch.PatchBuilder.Active() {
 		return self.Escape()
 	}
@@ -78,6 +73,11 @@ func (self *PatchBuildingHelper) RefreshPatchBuildingPanel(opts types.OnFocusOpt
 		return err
 	}
 
+	selectedLineIdx := -1
+	if opts.ClickedWindowName == "main" {
+		selectedLineIdx = clickedLineIdx(opts, diff)
+	}
+
 	secondaryDiff := self.c.Git().Patch.PatchBuilder.RenderPatchForFile(path, false, false)
 	if err != nil {
 		return err
@@ -123,7 +123,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) error {
 			// whenever we change commits, we should update branches because the upstream/downstream
 			// counts can change. Whenever we change branches we should also change commits
 			// e.g. in the case of switching branches.
-			refresh("commits and commit files", self.refreshCommitsAndCommitFiles)
+			refresh("commits and commit files", func() { self.refreshCommitsAndCommitFiles(options.Task) })
 
 			includeWorktreesWithBranches = scopeSet.Includes(types.WORKTREES)
 			refresh("reflog and branches", func() { self.refreshReflogAndBranches(includeWorktreesWithBranches) })
@@ -134,7 +134,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) error {
 		}
 
 		if scopeSet.Includes(types.SUB_COMMITS) {
-			refresh("sub commits", func() { _ = self.refreshSubCommitsWithLimit() })
+			refresh("sub commits", func() { _ = self.refreshSubCommitsWithLimit(options.Task) })
 		}
 
 		// reason we're not doing this if the COMMITS type is included is that if the COMMITS type _is_ included we will refresh the commit files context anyway
@@ -263,8 +263,8 @@ func (self *RefreshHelper) refreshReflogAndBranches(refreshWorktrees bool) {
 	self.refreshBranches(refreshWorktrees)
 }
 
-func (self *RefreshHelper) refreshCommitsAndCommitFiles() {
-	_ = self.refreshCommitsWithLimit()
+func (self *RefreshHelper) refreshCommitsAndCommitFiles(task gocui.Task) {
+	_ = self.refreshCommitsWithLimit(task)
 	ctx, ok := self.c.Contexts().CommitFiles.GetParentContext()
 	if ok && ctx.GetKey() == context.LOCAL_COMMITS_CONTEXT_KEY {
 		// This makes sense when we've e.g. just amended a commit, meaning we get a new commit SHA at the same position.
@@ -308,7 +308,7 @@ func (self *RefreshHelper) determineCheckedOutBranchName() string {
 	return ""
 }
 
-func (self *RefreshHelper) refreshCommitsWithLimit() error {
+func (self *RefreshHelper) refreshCommitsWithLimit(task gocui.Task) error {
 	self.c.Mutexes().LocalCommitsMutex.Lock()
 	defer self.c.Mutexes().LocalCommitsMutex.Unlock()
 
@@ -321,6 +321,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutBranchName,
 			All:                  self.c.Contexts().LocalCommits.GetShowWholeGitGraph(),
+			Task:                 task,
 		},
 	)
 	if err != nil {
@@ -334,7 +335,7 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	return self.refreshView(self.c.Contexts().LocalCommits)
 }
 
-func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
+func (self *RefreshHelper) refreshSubCommitsWithLimit(task gocui.Task) error {
 	self.c.Mutexes().SubCommitsMutex.Lock()
 	defer self.c.Mutexes().SubCommitsMutex.Unlock()
 
@@ -346,6 +347,7 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
 			RefForPushedStatus:      self.c.Contexts().SubCommits.GetRef().FullRefName(),
+			Task:                    task,
 		},
 	)
 	if err != nil {
@@ -17,6 +17,7 @@ type IRefsHelper interface {
 	CheckoutRef(ref string, options types.CheckoutRefOptions) error
 	GetCheckedOutRef() *models.Branch
 	CreateGitResetMenu(ref string) error
+	GitResetMenuItems(ref string) []*types.MenuItem
 	ResetToRef(ref string, strength string, envVars []string) error
 	NewBranch(from string, fromDescription string, suggestedBranchname string) error
 }
@@ -120,6 +121,13 @@ func (self *RefsHelper) ResetToRef(ref string, strength string, envVars []string
 }
 
 func (self *RefsHelper) CreateGitResetMenu(ref string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf("%s %s", self.c.Tr.ResetTo, ref),
+		Items: self.GitResetMenuItems(ref),
+	})
+}
+
+func (self *RefsHelper) GitResetMenuItems(ref string) []*types.MenuItem {
 	type strengthWithKey struct {
 		strength string
 		label    string
@@ -132,7 +140,7 @@ func (self *RefsHelper) CreateGitResetMenu(ref string) error {
 		{strength: "hard", label: "Hard reset", key: 'h'},
 	}
 
-	menuItems := lo.Map(strengths, func(row strengthWithKey, _ int) *types.MenuItem {
+	return lo.Map(strengths, func(row strengthWithKey, _ int) *types.MenuItem {
 		return &types.MenuItem{
 			LabelColumns: []string{
 				row.label,
@@ -145,11 +153,6 @@ func (self *RefsHelper) CreateGitResetMenu(ref string) error {
 			Key: row.key,
 		}
 	})
-
-	return self.c.Menu(types.CreateMenuOptions{
-		Title: fmt.Sprintf("%s %s", self.c.Tr.ResetTo, ref),
-		Items: menuItems,
-	})
 }
 
 func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggestedBranchName string) error {
@@ -29,16 +29,6 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) erro
 		return nil
 	}
 
-	mainSelectedLineIdx := -1
-	secondarySelectedLineIdx := -1
-	if focusOpts.ClickedViewLineIdx > 0 {
-		if secondaryFocused {
-			secondarySelectedLineIdx = focusOpts.ClickedViewLineIdx
-		} else {
-			mainSelectedLineIdx = focusOpts.ClickedViewLineIdx
-		}
-	}
-
 	mainContext := self.c.Contexts().Staging
 	secondaryContext := self.c.Contexts().StagingSecondary
 
@@ -55,6 +45,16 @@ func (self *StagingHelper) RefreshStagingPanel(focusOpts types.OnFocusOpts) erro
 	mainDiff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, false)
 	secondaryDiff := self.c.Git().WorkingTree.WorktreeFileDiff(file, true, true)
 
+	mainSelectedLineIdx := -1
+	secondarySelectedLineIdx := -1
+	if focusOpts.ClickedViewLineIdx > 0 {
+		if secondaryFocused {
+			secondarySelectedLineIdx = clickedLineIdx(focusOpts, secondaryDiff)
+		} else {
+			mainSelectedLineIdx = clickedLineIdx(focusOpts, mainDiff)
+		}
+	}
+
 	// grabbing locks here and releasing before we finish the function
 	// because pushing say the secondary context could mean entering this function
 	// again, and we don't want to have a deadlock
@@ -10,17 +10,46 @@ import (
 type TagsHelper struct {
 	c             *HelperCommon
 	commitsHelper *CommitsHelper
+	gpg           *GpgHelper
 }
 
-func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper) *TagsHelper {
+func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper, gpg *GpgHelper) *TagsHelper {
 	return &TagsHelper{
 		c:             c,
 		commitsHelper: commitsHelper,
+		gpg:           gpg,
 	}
 }
 
 func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
+	return self.openCreateTagPrompt(ref, false, onCreate)
+}
+
+// Signed tags are always annotated; if no message is given, the tag name is
+// used as the message.
+func (self *TagsHelper) OpenCreateSignedTagPrompt(ref string, onCreate func()) error {
+	return self.openCreateTagPrompt(ref, true, onCreate)
+}
+
+func (self *TagsHelper) openCreateTagPrompt(ref string, sign bool, onCreate func()) error {
+	doCreateSignedTag := func(tagName string, description string, force bool) error {
+		if description == "" {
+			description = tagName
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.CreateSignedTag)
+		cmdObj := self.c.Git().Tag.CreateAnnotatedCmdObj(tagName, ref, description, force, true)
+		return self.gpg.WithExplicitSigning(cmdObj, self.c.Tr.CreatingSignedTagStatus, func() error {
+			self.commitsHelper.OnCommitSuccess()
+			return nil
+		})
+	}
+
 	doCreateTag := func(tagName string, description string, force bool) error {
+		if sign {
+			return doCreateSignedTag(tagName, description, force)
+		}
+
 		return self.c.WithWaitingStatus(self.c.Tr.CreatingTag, func(gocui.Task) error {
 			if description != "" {
 				self.c.LogAction(self.c.Tr.Actions.CreateAnnotatedTag)
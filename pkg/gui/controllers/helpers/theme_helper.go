@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+)
+
+type ThemeHelper struct {
+	c *HelperCommon
+}
+
+func NewThemeHelper(c *HelperCommon) *ThemeHelper {
+	return &ThemeHelper{
+		c: c,
+	}
+}
+
+// The name of the theme in use: the one picked in the themes menu, unless the
+// config has changed since, or else the one the config selects
+func (self *ThemeHelper) CurrentThemeName() string {
+	appState := self.c.GetAppState()
+	if appState.ThemeName != "" && appState.ConfigThemeName == self.configThemeName() {
+		return appState.ThemeName
+	}
+
+	return self.configThemeName()
+}
+
+// The theme from the config, or else the colorblind theme in accessible mode,
+// or else the default theme, which uses the colors of gui.theme
+func (self *ThemeHelper) configThemeName() string {
+	if name := self.c.UserConfig.Gui.ThemeName; name != "" {
+		return name
+	}
+	if self.c.UserConfig.Gui.AccessibleMode {
+		return theme.ColorblindThemeName
+	}
+
+	return theme.DefaultThemeName
+}
+
+func (self *ThemeHelper) ThemeNames() []string {
+	return theme.Names(self.c.GetConfig().GetUserConfigDir())
+}
+
+// Applies the current theme. If it can't be loaded, the colors of gui.theme
+// are applied instead and the error is returned.
+func (self *ThemeHelper) ApplyTheme() error {
+	themeConfig, err := theme.Load(self.CurrentThemeName(), self.c.UserConfig.Gui.Theme, self.c.GetConfig().GetUserConfigDir())
+	theme.UpdateTheme(themeConfig)
+	theme.AccessibleMode = self.c.UserConfig.Gui.AccessibleMode
+
+	g := self.c.GocuiGui()
+	g.FgColor = theme.InactiveBorderColor
+	g.SelFgColor = theme.ActiveBorderColor
+	g.FrameColor = theme.InactiveBorderColor
+	g.SelFrameColor = theme.ActiveBorderColor
+
+	// views are only there when switching themes at runtime
+	for _, view := range g.Views() {
+		view.FgColor = theme.GocuiDefaultTextColor
+	}
+	if optionsView := self.c.Views().Options; optionsView != nil {
+		optionsView.FgColor = theme.OptionsColor
+	}
+	if searchPrefixView := self.c.Views().SearchPrefix; searchPrefixView != nil {
+		searchPrefixView.FgColor = gocui.ColorCyan
+	}
+
+	// the colors of authors and of the commit graph are cached
+	authors.SetCustomAuthors(self.c.UserConfig.Gui.AuthorColors)
+	presentation.ClearPipeSetCache()
+
+	return err
+}
+
+// Switches to the theme with the given name and remembers the choice across
+// sessions, until the config selects a different theme
+func (self *ThemeHelper) SwitchTheme(name string) error {
+	appState := self.c.GetAppState()
+	if name == self.configThemeName() {
+		appState.ThemeName = ""
+		appState.ConfigThemeName = ""
+	} else {
+		appState.ThemeName = name
+		appState.ConfigThemeName = self.configThemeName()
+	}
+	self.c.SaveAppStateAndLogError()
+
+	if err := self.ApplyTheme(); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+}
@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The LFS menu manages the locks that the LFS server holds on files, and
+// transfers LFS objects. Locks aren't part of the model, because listing them
+// means asking the server; they're fetched whenever the locks menu is opened.
+type LfsMenuAction struct {
+	c *ControllerCommon
+}
+
+// selectedPath is offered as the file to lock
+func (self *LfsMenuAction) Call(selectedPath string) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsOptions,
+		Items: []*types.MenuItem{
+			{
+				Label:     self.c.Tr.LfsShowLocks,
+				OnPress:   self.showLocks,
+				Key:       'l',
+				Tooltip:   self.c.Tr.LfsShowLocksTooltip,
+				OpensMenu: true,
+			},
+			{
+				Label: self.c.Tr.LfsLockFile,
+				OnPress: func() error {
+					return self.promptForLock(selectedPath)
+				},
+				Key:     'k',
+				Tooltip: self.c.Tr.LfsLockFileTooltip,
+			},
+			{
+				Label:   self.c.Tr.LfsFetch,
+				OnPress: self.fetch,
+				Key:     'f',
+				Tooltip: self.c.Tr.LfsFetchTooltip,
+			},
+			{
+				Label:   self.c.Tr.LfsPull,
+				OnPress: self.pull,
+				Key:     'p',
+				Tooltip: self.c.Tr.LfsPullTooltip,
+			},
+			{
+				Label:   self.c.Tr.LfsPrune,
+				OnPress: self.prune,
+				Key:     'r',
+				Tooltip: self.c.Tr.LfsPruneTooltip,
+			},
+		},
+	})
+}
+
+func (self *LfsMenuAction) showLocks() error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingLocksStatus, func(gocui.Task) error {
+		locks, err := self.c.Git().Lfs.Locks()
+		if err != nil {
+			return err
+		}
+
+		if len(locks) == 0 {
+			self.c.Toast(self.c.Tr.NoLfsLocks)
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showLocksMenu(locks)
+		})
+		return nil
+	})
+}
+
+func (self *LfsMenuAction) showLocksMenu(locks []*models.LfsLock) error {
+	menuItems := lo.Map(locks, func(lock *models.LfsLock, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				lock.Path,
+				style.FgYellow.Sprint(lock.Owner),
+				style.FgBlue.Sprint(utils.UnixToTimeAgo(lock.LockedAt.Unix())),
+			},
+			OnPress: func() error {
+				return self.showLockMenu(lock)
+			},
+			OpensMenu: true,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsLocksTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *LfsMenuAction) showLockMenu(lock *models.LfsLock) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: lock.Path,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.LfsUnlock,
+				OnPress: func() error {
+					return self.unlock(lock, false)
+				},
+				Key: 'u',
+			},
+			{
+				Label: self.c.Tr.LfsForceUnlock,
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title: self.c.Tr.LfsForceUnlock,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.LfsForceUnlockPrompt, map[string]string{
+							"owner": lock.Owner,
+							"path":  lock.Path,
+						}),
+						HandleConfirm: func() error {
+							return self.unlock(lock, true)
+						},
+					})
+				},
+				Key:     'f',
+				Tooltip: self.c.Tr.LfsForceUnlockTooltip,
+			},
+		},
+	})
+}
+
+func (self *LfsMenuAction) unlock(lock *models.LfsLock, force bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UnlockingStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsUnlock)
+		return self.c.Git().Lfs.Unlock(task, lock.ID, force)
+	})
+}
+
+func (self *LfsMenuAction) promptForLock(initialPath string) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.LfsLockFilePrompt,
+		InitialContent:      initialPath,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.LockingStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.LfsLock)
+				return self.c.Git().Lfs.Lock(task, path)
+			})
+		},
+	})
+}
+
+func (self *LfsMenuAction) fetch() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsFetch)
+		return self.c.Git().Lfs.Fetch(task)
+	})
+}
+
+func (self *LfsMenuAction) pull() error {
+	return self.c.WithWaitingStatus(self.c.Tr.PullingStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsPull)
+		if err := self.c.Git().Lfs.Pull(task); err != nil {
+			return err
+		}
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	})
+}
+
+func (self *LfsMenuAction) prune() error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.LfsPrune,
+		Prompt: self.c.Tr.LfsPrunePrompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.PruningStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.LfsPrune)
+				return self.c.Git().Lfs.Prune()
+			})
+		},
+	})
+}
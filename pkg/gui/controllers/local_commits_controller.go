@@ -195,7 +195,7 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() error {
 						}))
 			} else {
 				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = types.NewRunPtyDiffTask(cmdObj.GetCmd())
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -864,9 +864,9 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 						self.context().SetLimitCommits(false)
 					}
 
-					return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+					return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(task gocui.Task) error {
 						return self.c.Refresh(
-							types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}},
+							types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}, Task: task},
 						)
 					})
 				},
@@ -907,11 +907,12 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 					onPress := func(value string) func() error {
 						return func() error {
 							self.c.UserConfig.Git.Log.Order = value
-							return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+							return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(task gocui.Task) error {
 								return self.c.Refresh(
 									types.RefreshOptions{
 										Mode:  types.SYNC,
 										Scope: []types.RefreshableView{types.COMMITS},
+										Task:  task,
 									},
 								)
 							})
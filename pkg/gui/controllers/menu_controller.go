@@ -70,7 +70,7 @@ func (self *MenuController) close() error {
 		return nil
 	}
 
-	return self.c.PopContext()
+	return self.context().OnMenuClose()
 }
 
 func (self *MenuController) Context() types.Context {
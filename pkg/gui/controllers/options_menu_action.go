@@ -1,7 +1,11 @@
 package controllers
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -22,6 +26,15 @@ func (self *OptionsMenuAction) Call() error {
 
 	menuItems := []*types.MenuItem{}
 
+	conflicts := self.c.Helpers().Keybindings.Conflicts()
+	if len(conflicts) > 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:     utils.ResolvePlaceholderString(self.c.Tr.ViewKeybindingConflicts, map[string]string{"count": strconv.Itoa(len(conflicts))}),
+			OpensMenu: true,
+			OnPress:   func() error { return self.showConflicts(conflicts) },
+		})
+	}
+
 	appendBindings := func(bindings []*types.Binding, section *types.MenuSection) {
 		menuItems = append(menuItems,
 			lo.Map(bindings, func(binding *types.Binding, _ int) *types.MenuItem {
@@ -59,6 +72,34 @@ func (self *OptionsMenuAction) Call() error {
 	})
 }
 
+func (self *OptionsMenuAction) showConflicts(conflicts []*keybindings.Conflict) error {
+	menuItems := lo.Map(conflicts, func(conflict *keybindings.Conflict, _ int) *types.MenuItem {
+		viewName := conflict.ViewName
+		if viewName == "" {
+			viewName = self.c.Tr.KeybindingsMenuSectionGlobal
+		}
+
+		shadows := utils.ResolvePlaceholderString(self.c.Tr.KeybindingConflictShadows, map[string]string{
+			"bindings": strings.Join(conflict.ShadowedDescriptions(), ", "),
+		})
+
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgCyan.Sprint(keybindings.LabelFromKey(conflict.Key)),
+				viewName,
+				conflict.Effective.Description,
+				style.FgYellow.Sprint(shadows),
+			},
+			OnPress: func() error { return nil },
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.KeybindingConflicts,
+		Items: menuItems,
+	})
+}
+
 // Returns three slices of bindings: local, global, and navigation
 func (self *OptionsMenuAction) getBindings(context types.Context) ([]*types.Binding, []*types.Binding, []*types.Binding) {
 	var bindingsGlobal, bindingsPanel, bindingsNavigation []*types.Binding
@@ -142,8 +142,10 @@ func (self *PatchExplorerController) GetMouseKeybindings(opts types.KeybindingsO
 				}
 
 				return self.c.PushContext(self.context, types.OnFocusOpts{
-					ClickedWindowName:  self.context.GetWindowName(),
-					ClickedViewLineIdx: opts.Y,
+					ClickedWindowName:      self.context.GetWindowName(),
+					ClickedViewLineIdx:     opts.Y,
+					ClickedViewColumn:      opts.X,
+					ClickedSideBySideWidth: self.clickedSideBySideWidth(),
 				})
 			},
 		},
@@ -293,3 +295,13 @@ func (self *PatchExplorerController) withLock(f func() error) func() error {
 		return f()
 	}
 }
+
+// While a side panel is focused, this view shows the diff of the selected item
+// rather than the staging or patch building state, and it may be shown side by
+// side
+func (self *PatchExplorerController) clickedSideBySideWidth() int {
+	if self.c.CurrentStaticContext().GetKind() != types.SIDE_CONTEXT {
+		return 0
+	}
+	return self.c.Helpers().Diff.SideBySideWidth(self.context.GetView())
+}
@@ -0,0 +1,346 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// The push menu lets you change how the current branch is pushed before
+// pushing it, or push other refs instead. Pressing a menu item closes the menu,
+// so changing a setting re-opens the menu with the same line selected.
+type PushMenuAction struct {
+	c *ControllerCommon
+}
+
+type pushMenuSettings struct {
+	branch *models.Branch
+	remote string
+	// If empty, the current branch is pushed
+	refspecs        []string
+	pushOptions     []string
+	atomic          bool
+	force           bool
+	forceIfIncludes bool
+	setUpstream     bool
+}
+
+func (self *PushMenuAction) Call(branch *models.Branch) error {
+	if len(self.c.Model().Remotes) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoRemotesToPushTo)
+	}
+
+	return self.show(&pushMenuSettings{
+		branch:      branch,
+		remote:      self.defaultRemote(branch),
+		setUpstream: !branch.IsTrackingRemote(),
+	}, 'p')
+}
+
+func (self *PushMenuAction) defaultRemote(branch *models.Branch) string {
+	if branch.IsTrackingRemote() {
+		return branch.UpstreamRemote
+	}
+
+	remoteNames := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) string {
+		return remote.Name
+	})
+	if lo.Contains(remoteNames, "origin") {
+		return "origin"
+	}
+	return remoteNames[0]
+}
+
+// Shows the menu with the item that has the given key selected
+func (self *PushMenuAction) show(settings *pushMenuSettings, selectedKey types.Key) error {
+	forceDisabledReason := ""
+	if self.c.UserConfig.Git.DisableForcePushing {
+		forceDisabledReason = self.c.Tr.ForcePushDisabled
+	}
+
+	forceIfIncludesDisabledReason := ""
+	if self.c.Git().Version.IsOlderThan(2, 30, 0) {
+		forceIfIncludesDisabledReason = self.c.Tr.ForceIfIncludesRequiresNewerGit
+	} else if !settings.force {
+		forceIfIncludesDisabledReason = self.c.Tr.ForceIfIncludesRequiresForce
+	}
+
+	clearPushOptionsDisabledReason := ""
+	if len(settings.pushOptions) == 0 {
+		clearPushOptionsDisabledReason = self.c.Tr.NoPushOptions
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{style.FgGreen.Sprint(self.c.Tr.Push), self.describeRefs(settings)},
+			OnPress: func() error {
+				return self.push(settings)
+			},
+			Key: 'p',
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PushPreview},
+			OnPress: func() error {
+				return self.preview(settings)
+			},
+			Key:       'd',
+			Tooltip:   self.c.Tr.PushPreviewTooltip,
+			OpensMenu: true,
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PushRemote, style.FgCyan.Sprint(settings.remote)},
+			OnPress: func() error {
+				return self.selectRemote(settings)
+			},
+			Key:       'r',
+			OpensMenu: true,
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PushRefspecs, style.FgCyan.Sprint(strings.Join(settings.refspecs, " "))},
+			OnPress: func() error {
+				return self.promptForRefspecs(settings)
+			},
+			Key:     'b',
+			Tooltip: self.c.Tr.PushRefspecsTooltip,
+		},
+		{
+			LabelColumns: []string{self.c.Tr.AddPushOption, style.FgCyan.Sprint(strings.Join(settings.pushOptions, " "))},
+			OnPress: func() error {
+				return self.promptForPushOption(settings)
+			},
+			Key:     'o',
+			Tooltip: self.c.Tr.AddPushOptionTooltip,
+		},
+		{
+			LabelColumns: []string{self.c.Tr.ClearPushOptions},
+			OnPress: func() error {
+				settings.pushOptions = nil
+				return self.show(settings, 'O')
+			},
+			Key:            'O',
+			DisabledReason: clearPushOptionsDisabledReason,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(settings.atomic) + " " + self.c.Tr.AtomicPush},
+			OnPress: func() error {
+				settings.atomic = !settings.atomic
+				return self.show(settings, 'a')
+			},
+			Key:     'a',
+			Tooltip: self.c.Tr.AtomicPushTooltip,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(settings.force) + " " + self.c.Tr.ForceWithLease},
+			OnPress: func() error {
+				settings.force = !settings.force
+				return self.show(settings, 'f')
+			},
+			Key:            'f',
+			Tooltip:        self.c.Tr.ForceWithLeaseTooltip,
+			DisabledReason: forceDisabledReason,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(settings.force && settings.forceIfIncludes) + " " + self.c.Tr.ForceIfIncludes},
+			OnPress: func() error {
+				settings.forceIfIncludes = !settings.forceIfIncludes
+				return self.show(settings, 'i')
+			},
+			Key:            'i',
+			Tooltip:        self.c.Tr.ForceIfIncludesTooltip,
+			DisabledReason: forceIfIncludesDisabledReason,
+		},
+		{
+			LabelColumns: []string{helpers.Checkbox(settings.setUpstream) + " " + self.c.Tr.SetUpstreamWhenPushing},
+			OnPress: func() error {
+				settings.setUpstream = !settings.setUpstream
+				return self.show(settings, 'u')
+			},
+			Key:     'u',
+			Tooltip: self.c.Tr.SetUpstreamWhenPushingTooltip,
+		},
+	}
+
+	if err := self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PushMenuTitle, Items: menuItems}); err != nil {
+		return err
+	}
+
+	_, selectedLineIdx, _ := lo.FindIndexOf(menuItems, func(item *types.MenuItem) bool {
+		return item.Key == selectedKey
+	})
+	self.c.Contexts().Menu.SetSelectedLineIdx(selectedLineIdx)
+	return self.c.PostRefreshUpdate(self.c.Contexts().Menu)
+}
+
+// E.g. 'feature → origin/feature'
+func (self *PushMenuAction) describeRefs(settings *pushMenuSettings) string {
+	return strings.Join(lo.Map(self.refspecs(settings), func(refspec string, _ int) string {
+		from, to, found := strings.Cut(refspec, ":")
+		if !found {
+			to = from
+		}
+		return fmt.Sprintf("%s → %s/%s", from, settings.remote, to)
+	}), ", ")
+}
+
+func (self *PushMenuAction) refspecs(settings *pushMenuSettings) []string {
+	if len(settings.refspecs) > 0 {
+		return settings.refspecs
+	}
+
+	branch := settings.branch
+	if branch.IsTrackingRemote() && settings.remote == branch.UpstreamRemote && branch.UpstreamBranch != branch.Name {
+		return []string{branch.Name + ":" + branch.UpstreamBranch}
+	}
+	return []string{branch.Name}
+}
+
+func (self *PushMenuAction) pushOpts(settings *pushMenuSettings) git_commands.PushOpts {
+	return git_commands.PushOpts{
+		Force:           settings.force,
+		ForceIfIncludes: settings.forceIfIncludes,
+		UpstreamRemote:  settings.remote,
+		SetUpstream:     settings.setUpstream,
+		Refspecs:        self.refspecs(settings),
+		Atomic:          settings.atomic,
+		PushOptions:     settings.pushOptions,
+	}
+}
+
+func (self *PushMenuAction) push(settings *pushMenuSettings) error {
+	return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.Push)
+		if err := self.c.Git().Sync.Push(task, self.pushOpts(settings)); err != nil {
+			return err
+		}
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	})
+}
+
+func (self *PushMenuAction) preview(settings *pushMenuSettings) error {
+	return self.c.WithWaitingStatus(self.c.Tr.PreviewingPushStatus, func(task gocui.Task) error {
+		updates, err := self.c.Git().Sync.PushDryRun(task, self.pushOpts(settings))
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showPreview(settings, updates)
+		})
+		return nil
+	})
+}
+
+func (self *PushMenuAction) showPreview(settings *pushMenuSettings, updates []*git_commands.RefUpdate) error {
+	if len(updates) == 0 {
+		return self.c.Alert(self.c.Tr.PushPreviewTitle, self.c.Tr.NothingToPush)
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{style.FgGreen.Sprint(self.c.Tr.Push), self.describeRefs(settings)},
+			OnPress: func() error {
+				return self.push(settings)
+			},
+			Key: 'p',
+		},
+	}
+
+	for _, update := range updates {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: presentation.GetRefUpdateDisplayStrings(update),
+			OnPress:      func() error { return nil },
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PushPreviewTitle, Items: menuItems})
+}
+
+func (self *PushMenuAction) selectRemote(settings *pushMenuSettings) error {
+	menuItems := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{remote.Name, style.FgBlue.Sprint(strings.Join(remote.Urls, ", "))},
+			OnPress: func() error {
+				settings.remote = remote.Name
+				return self.show(settings, 'r')
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PushRemote, Items: menuItems})
+}
+
+func (self *PushMenuAction) promptForRefspecs(settings *pushMenuSettings) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PushRefspecsPrompt,
+		InitialContent:      strings.Join(settings.refspecs, " "),
+		FindSuggestionsFunc: self.refspecSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			settings.refspecs = strings.Fields(response)
+			return self.show(settings, 'b')
+		},
+	})
+}
+
+// Suggests local branches and tags for the last refspec being typed
+func (self *PushMenuAction) refspecSuggestionsFunc() func(string) []*types.Suggestion {
+	refNames := append(
+		lo.Map(self.c.Model().Branches, func(branch *models.Branch, _ int) string {
+			return branch.Name
+		}),
+		lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string {
+			return tag.Name
+		})...,
+	)
+	findRefs := helpers.FuzzySearchFunc(refNames)
+
+	return func(input string) []*types.Suggestion {
+		prefix := ""
+		if idx := strings.LastIndex(input, " "); idx != -1 {
+			prefix, input = input[:idx+1], input[idx+1:]
+		}
+
+		return lo.Map(findRefs(input), func(suggestion *types.Suggestion, _ int) *types.Suggestion {
+			return &types.Suggestion{
+				Value: prefix + suggestion.Value,
+				Label: suggestion.Label,
+			}
+		})
+	}
+}
+
+const maxPushOptionsHistory = 100
+
+func (self *PushMenuAction) promptForPushOption(settings *pushMenuSettings) error {
+	// most recently used first
+	history := lo.Reverse(append([]string{}, self.c.GetAppState().PushOptionsHistory...))
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PushOptionPrompt,
+		FindSuggestionsFunc: helpers.FuzzySearchFunc(history),
+		HandleConfirm: func(response string) error {
+			pushOption := strings.TrimSpace(response)
+			if pushOption == "" {
+				return self.show(settings, 'o')
+			}
+
+			settings.pushOptions = lo.Uniq(append(settings.pushOptions, pushOption))
+
+			history := append(lo.Without(self.c.GetAppState().PushOptionsHistory, pushOption), pushOption)
+			if len(history) > maxPushOptionsHistory {
+				history = history[len(history)-maxPushOptionsHistory:]
+			}
+			self.c.GetAppState().PushOptionsHistory = history
+			self.c.SaveAppStateAndLogError()
+
+			return self.show(settings, 'o')
+		},
+	})
+}
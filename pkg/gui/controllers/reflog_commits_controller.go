@@ -1,8 +1,12 @@
 package controllers
 
 import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type ReflogCommitsController struct {
@@ -21,6 +25,20 @@ func NewReflogCommitsController(
 	}
 }
 
+func (self *ReflogCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Commits.ViewRecoveryOptions),
+			Handler:     self.openRecoveryMenu,
+			Description: self.c.Tr.RecoverLostCommits,
+			Tooltip:     self.c.Tr.RecoverLostCommitsTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
 func (self *ReflogCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -39,7 +57,7 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() error {
 			} else {
 				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
 
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = types.NewRunPtyDiffTask(cmdObj.GetCmd())
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -52,3 +70,137 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() error {
 		})
 	}
 }
+
+func (self *ReflogCommitsController) openRecoveryMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RecoverLostCommits,
+		Items: []*types.MenuItem{
+			{
+				Label:     self.c.Tr.DanglingCommits,
+				Tooltip:   self.c.Tr.DanglingCommitsTooltip,
+				OnPress:   self.showDanglingCommits,
+				Key:       'd',
+				OpensMenu: true,
+			},
+			{
+				Label:     self.c.Tr.BranchReflog,
+				Tooltip:   self.c.Tr.BranchReflogTooltip,
+				OnPress:   self.promptForBranchReflog,
+				Key:       'b',
+				OpensMenu: true,
+			},
+		},
+	})
+}
+
+func (self *ReflogCommitsController) showDanglingCommits() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FindingDanglingCommits, func(gocui.Task) error {
+		commits, err := self.c.Git().Loaders.ReflogCommitLoader.GetDanglingCommits()
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(commits) == 0 {
+				return self.c.ErrorMsg(self.c.Tr.NoDanglingCommits)
+			}
+
+			return self.showRecoverableCommits(self.c.Tr.DanglingCommits, commits)
+		})
+		return nil
+	})
+}
+
+func (self *ReflogCommitsController) promptForBranchReflog() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.BranchReflog,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(branchName string) error {
+			commits, err := self.c.Git().Loaders.ReflogCommitLoader.GetBranchReflogCommits(branchName)
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			placeholders := map[string]string{"branchName": branchName}
+			if len(commits) == 0 {
+				return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.NoBranchReflogEntries, placeholders))
+			}
+
+			return self.showRecoverableCommits(utils.ResolvePlaceholderString(self.c.Tr.BranchReflogTitle, placeholders), commits)
+		},
+	})
+}
+
+func (self *ReflogCommitsController) showRecoverableCommits(title string, commits []*models.Commit) error {
+	menuItems := make([]*types.MenuItem, 0, len(commits))
+	for _, commit := range commits {
+		commit := commit
+		kind := ""
+		if commit.IsStash() {
+			kind = style.FgMagenta.Sprint(self.c.Tr.DroppedStash)
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				style.FgYellow.Sprint(commit.ShortSha()),
+				style.FgBlue.Sprint(utils.UnixToTimeAgo(commit.UnixTimestamp)),
+				kind,
+				commit.Name,
+			},
+			OnPress: func() error {
+				return self.showRecoverCommitMenu(commit)
+			},
+			OpensMenu: true,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: menuItems,
+	})
+}
+
+func (self *ReflogCommitsController) showRecoverCommitMenu(commit *models.Commit) error {
+	restoreAsStashDisabledReason := ""
+	if !commit.IsStash() {
+		restoreAsStashDisabledReason = self.c.Tr.NotAStashCommit
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.RecoverCommitTitle, map[string]string{"sha": commit.ShortSha()}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.RestoreAsBranch,
+				OnPress: func() error {
+					return self.c.Helpers().Refs.NewBranch(commit.Sha, commit.ShortSha(), "")
+				},
+				Key: 'n',
+			},
+			{
+				Label:          self.c.Tr.RestoreAsStash,
+				Tooltip:        self.c.Tr.RestoreAsStashTooltip,
+				DisabledReason: restoreAsStashDisabledReason,
+				OnPress: func() error {
+					return self.restoreStash(commit)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.Checkout,
+				OnPress: func() error {
+					return self.c.Helpers().Refs.CheckoutRef(commit.Sha, types.CheckoutRefOptions{})
+				},
+				Key: 'c',
+			},
+		},
+	})
+}
+
+func (self *ReflogCommitsController) restoreStash(commit *models.Commit) error {
+	self.c.LogAction(self.c.Tr.Actions.RestoreStash)
+	if err := self.c.Git().Stash.Store(commit.Sha, commit.Name); err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.STASH}})
+}
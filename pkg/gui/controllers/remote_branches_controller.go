@@ -59,10 +59,11 @@ func (self *RemoteBranchesController) GetKeybindings(opts types.KeybindingsOpts)
 			Description: self.c.Tr.SetAsUpstream,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Commits.ViewResetOptions),
-			Handler:     self.checkSelected(self.createResetMenu),
-			Description: self.c.Tr.ViewResetOptions,
-			OpensMenu:   true,
+			Key:          opts.GetKey(opts.Config.Commits.ViewResetOptions),
+			Handler:      self.checkSelected(self.createResetMenu),
+			Description:  self.c.Tr.ViewResetOptions,
+			OpensMenu:    true,
+			GetMenuItems: self.resetMenuItems,
 		},
 	}
 }
@@ -125,6 +126,15 @@ func (self *RemoteBranchesController) createResetMenu(selectedBranch *models.Rem
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.FullName())
 }
 
+func (self *RemoteBranchesController) resetMenuItems() []*types.MenuItem {
+	selectedBranch := self.context().GetSelected()
+	if selectedBranch == nil {
+		return nil
+	}
+
+	return self.c.Helpers().Refs.GitResetMenuItems(selectedBranch.FullName())
+}
+
 func (self *RemoteBranchesController) setAsUpstream(selectedBranch *models.RemoteBranch) error {
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 
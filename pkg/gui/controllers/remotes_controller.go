@@ -7,9 +7,11 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemotesController struct {
@@ -58,6 +60,12 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Handler:     self.checkSelected(self.edit),
 			Description: self.c.Tr.EditRemote,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.ViewRemoteOptions),
+			Handler:     self.checkSelected(self.viewRemoteOptions),
+			Description: self.c.Tr.ViewRemoteOptions,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -191,6 +199,7 @@ func (self *RemotesController) edit(remote *models.Remote) error {
 					if err := self.c.Git().Remote.UpdateRemoteUrl(updatedRemoteName, updatedRemoteUrl); err != nil {
 						return self.c.Error(err)
 					}
+					self.c.Helpers().Journal.RecordRemoteUrlChange(updatedRemoteName, url, updatedRemoteUrl)
 					return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
 				},
 			})
@@ -199,16 +208,258 @@ func (self *RemotesController) edit(remote *models.Remote) error {
 }
 
 func (self *RemotesController) fetch(remote *models.Remote) error {
-	return self.c.WithWaitingStatus(self.c.Tr.FetchingRemoteStatus, func(task gocui.Task) error {
-		err := self.c.Git().Sync.FetchRemote(task, remote.Name)
+	return self.c.Helpers().Fetch.FetchRemotes(self.c.Tr.FetchingRemoteStatus, []string{remote.Name})
+}
+
+func (self *RemotesController) viewRemoteOptions(remote *models.Remote) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.RemoteOptionsTitle, map[string]string{"remoteName": remote.Name}),
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.PruneRemote,
+				Tooltip: self.c.Tr.PruneRemoteTooltip,
+				OnPress: func() error { return self.prune(remote) },
+				Key:     'p',
+			},
+			{
+				Label: self.c.Tr.CleanUpMergedRemoteBranches,
+				Tooltip: utils.ResolvePlaceholderString(self.c.Tr.CleanUpMergedRemoteBranchesTooltip, map[string]string{
+					"mainBranches": strings.Join(self.c.UserConfig.Git.MainBranches, ", "),
+				}),
+				OnPress:   func() error { return self.cleanUpMergedBranches(remote) },
+				OpensMenu: true,
+				Key:       'c',
+			},
+			{
+				Label:     self.c.Tr.EditPushUrls,
+				Tooltip:   self.c.Tr.EditPushUrlsTooltip,
+				OnPress:   func() error { return self.editPushUrls(remote) },
+				OpensMenu: true,
+				Key:       'u',
+			},
+			{
+				Label:     self.c.Tr.EditFetchRefspecs,
+				Tooltip:   self.c.Tr.EditFetchRefspecsTooltip,
+				OnPress:   func() error { return self.editFetchRefspecs(remote) },
+				OpensMenu: true,
+				Key:       'r',
+			},
+		},
+	})
+}
+
+func (self *RemotesController) prune(remote *models.Remote) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingRemoteStatus, func(gocui.Task) error {
+		staleBranches, err := self.c.Git().Remote.PruneDryRun(remote.Name)
 		if err != nil {
-			_ = self.c.Error(err)
+			return self.c.Error(err)
+		}
+
+		if len(staleBranches) == 0 {
+			self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.NothingToPrune, map[string]string{"remoteName": remote.Name}))
+			return nil
 		}
 
-		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+		self.c.OnUIThread(func() error {
+			return self.confirmPrune(remote, staleBranches)
+		})
+		return nil
 	})
 }
 
+func (self *RemotesController) confirmPrune(remote *models.Remote, staleBranches []string) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.PruneRemote,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.PruneRemotePrompt, map[string]string{
+			"remoteName": remote.Name,
+			"branches":   strings.Join(staleBranches, "\n"),
+		}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.PruningStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PruneRemote)
+				if err := self.c.Git().Remote.FetchAndPrune(task, remote.Name); err != nil {
+					_ = self.c.Error(err)
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+			})
+		},
+	})
+}
+
+func (self *RemotesController) cleanUpMergedBranches(remote *models.Remote) error {
+	mergedBranches, err := self.c.Git().Remote.MergedRemoteBranches(remote.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	if len(mergedBranches) == 0 {
+		return self.c.ErrorMsg(utils.ResolvePlaceholderString(self.c.Tr.NoMergedRemoteBranches, map[string]string{"remoteName": remote.Name}))
+	}
+
+	items := lo.Map(mergedBranches, func(branchName string, _ int) *helpers.ChecklistItem {
+		return &helpers.ChecklistItem{Label: branchName}
+	})
+
+	return self.c.Helpers().Checklist.Show(helpers.ChecklistOpts{
+		Title:        utils.ResolvePlaceholderString(self.c.Tr.MergedRemoteBranchesTitle, map[string]string{"remoteName": remote.Name}),
+		Items:        items,
+		ConfirmLabel: self.c.Tr.DeleteSelectedBranches,
+		HandleConfirm: func(checked []*helpers.ChecklistItem) error {
+			branchNames := lo.Map(checked, func(item *helpers.ChecklistItem, _ int) string { return item.Label })
+
+			return self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.DeleteSelectedBranches,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.DeleteRemoteBranchesPrompt, map[string]string{
+					"remoteName": remote.Name,
+					"branches":   strings.Join(branchNames, "\n"),
+				}),
+				HandleConfirm: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteBranches)
+						if err := self.c.Git().Remote.DeleteRemoteBranches(task, remote.Name, branchNames); err != nil {
+							_ = self.c.Error(err)
+						}
+
+						return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+					})
+				},
+			})
+		},
+	})
+}
+
+func (self *RemotesController) editPushUrls(remote *models.Remote) error {
+	urls, err := self.c.Git().Remote.GetPushUrls(remote.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.editConfigValuesMenu(editConfigValuesMenuOpts{
+		title:       utils.ResolvePlaceholderString(self.c.Tr.PushUrlsTitle, map[string]string{"remoteName": remote.Name}),
+		values:      urls,
+		addLabel:    self.c.Tr.AddPushUrl,
+		addPrompt:   self.c.Tr.NewPushUrl,
+		editPrompt:  self.c.Tr.EditPushUrl,
+		actionLabel: self.c.Tr.Actions.UpdateRemotePushUrl,
+		add: func(url string) error {
+			return self.c.Git().Remote.AddPushUrl(remote.Name, url)
+		},
+		update: func(oldUrl string, newUrl string) error {
+			return self.c.Git().Remote.UpdatePushUrl(remote.Name, oldUrl, newUrl)
+		},
+		remove: func(url string) error {
+			return self.c.Git().Remote.RemovePushUrl(remote.Name, url)
+		},
+	})
+}
+
+func (self *RemotesController) editFetchRefspecs(remote *models.Remote) error {
+	refspecs, err := self.c.Git().Remote.GetFetchRefspecs(remote.Name)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	return self.editConfigValuesMenu(editConfigValuesMenuOpts{
+		title:       utils.ResolvePlaceholderString(self.c.Tr.FetchRefspecsTitle, map[string]string{"remoteName": remote.Name}),
+		values:      refspecs,
+		addLabel:    self.c.Tr.AddFetchRefspec,
+		addPrompt:   self.c.Tr.NewFetchRefspec,
+		editPrompt:  self.c.Tr.EditFetchRefspec,
+		actionLabel: self.c.Tr.Actions.UpdateRemoteFetchRefspec,
+		add: func(refspec string) error {
+			return self.c.Git().Remote.AddFetchRefspec(remote.Name, refspec)
+		},
+		update: func(oldRefspec string, newRefspec string) error {
+			return self.c.Git().Remote.UpdateFetchRefspec(remote.Name, oldRefspec, newRefspec)
+		},
+		remove: func(refspec string) error {
+			return self.c.Git().Remote.RemoveFetchRefspec(remote.Name, refspec)
+		},
+	})
+}
+
+type editConfigValuesMenuOpts struct {
+	title       string
+	values      []string
+	addLabel    string
+	addPrompt   string
+	editPrompt  string
+	actionLabel string
+	add         func(value string) error
+	update      func(oldValue string, newValue string) error
+	remove      func(value string) error
+}
+
+// shows a menu with one item per value (pressing it opens a menu for editing
+// or removing the value) plus an item for adding a new value
+func (self *RemotesController) editConfigValuesMenu(opts editConfigValuesMenuOpts) error {
+	run := func(f func() error) error {
+		self.c.LogAction(opts.actionLabel)
+		if err := f(); err != nil {
+			return self.c.Error(err)
+		}
+		return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REMOTES}})
+	}
+
+	menuItems := lo.Map(opts.values, func(value string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: value,
+			OnPress: func() error {
+				return self.c.Menu(types.CreateMenuOptions{
+					Title: value,
+					Items: []*types.MenuItem{
+						{
+							Label: self.c.Tr.EditConfigValue,
+							OnPress: func() error {
+								return self.c.Prompt(types.PromptOpts{
+									Title:          opts.editPrompt,
+									InitialContent: value,
+									HandleConfirm: func(newValue string) error {
+										newValue = strings.TrimSpace(newValue)
+										if newValue == value || newValue == "" {
+											return nil
+										}
+										return run(func() error { return opts.update(value, newValue) })
+									},
+								})
+							},
+							Key: 'e',
+						},
+						{
+							Label: self.c.Tr.RemoveConfigValue,
+							OnPress: func() error {
+								return run(func() error { return opts.remove(value) })
+							},
+							Key: 'd',
+						},
+					},
+				})
+			},
+			OpensMenu: true,
+		}
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: opts.addLabel,
+		OnPress: func() error {
+			return self.c.Prompt(types.PromptOpts{
+				Title: opts.addPrompt,
+				HandleConfirm: func(newValue string) error {
+					newValue = strings.TrimSpace(newValue)
+					if newValue == "" {
+						return nil
+					}
+					return run(func() error { return opts.add(newValue) })
+				},
+			})
+		},
+		Key: 'n',
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: opts.title, Items: menuItems})
+}
+
 func (self *RemotesController) checkSelected(callback func(*models.Remote) error) func() error {
 	return func() error {
 		file := self.context().GetSelected()
@@ -167,7 +167,14 @@ func (self *StagingController) ToggleStaged() error {
 }
 
 func (self *StagingController) DiscardSelection() error {
-	reset := func() error { return self.applySelectionAndRefresh(true) }
+	reset := func() error {
+		if !self.staged {
+			if _, err := self.c.Helpers().DiscardedChanges.Snapshot(self.c.Tr.DiscardChangeTitle, []string{self.FilePath()}); err != nil {
+				return self.c.Error(err)
+			}
+		}
+		return self.applySelectionAndRefresh(true)
+	}
 
 	if !self.staged && !self.c.UserConfig.Gui.SkipDiscardChangeWarning {
 		return self.c.Confirm(types.ConfirmOpts{
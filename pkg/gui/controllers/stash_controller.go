@@ -63,7 +63,7 @@ func (self *StashController) GetOnRenderToMain() func() error {
 			if stashEntry == nil {
 				task = types.NewRenderStringTask(self.c.Tr.NoStashEntries)
 			} else {
-				task = types.NewRunPtyTask(
+				task = types.NewRunPtyDiffTask(
 					self.c.Git().Stash.ShowStashEntryCmdObj(stashEntry.Index).GetCmd(),
 				)
 			}
@@ -153,11 +153,15 @@ func (self *StashController) handleStashDrop(stashEntry *models.StashEntry) erro
 		Prompt: self.c.Tr.SureDropStashEntry,
 		HandleConfirm: func() error {
 			self.c.LogAction(self.c.Tr.Actions.Stash)
+			sha, shaErr := self.c.Git().Stash.Sha(stashEntry.Index)
 			err := self.c.Git().Stash.Drop(stashEntry.Index)
 			_ = self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH}})
 			if err != nil {
 				return self.c.Error(err)
 			}
+			if shaErr == nil {
+				self.c.Helpers().Journal.RecordStashDrop(sha, stashEntry.Name)
+			}
 			return nil
 		},
 	})
@@ -57,11 +57,41 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     self.showAllBranchLogs,
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.SwitchTheme),
+			Handler:     self.openThemesMenu,
+			Description: self.c.Tr.SwitchTheme,
+			Tooltip:     self.c.Tr.SwitchThemeTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
 }
 
+func (self *StatusController) openThemesMenu() error {
+	currentThemeName := self.c.Helpers().Theme.CurrentThemeName()
+
+	menuItems := lo.Map(self.c.Helpers().Theme.ThemeNames(), func(name string, _ int) *types.MenuItem {
+		current := ""
+		if name == currentThemeName {
+			current = style.FgGreen.Sprint(self.c.Tr.CurrentTheme)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{name, current},
+			OnPress: func() error {
+				return self.c.Helpers().Theme.SwitchTheme(name)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ThemesMenuTitle,
+		Items: menuItems,
+	})
+}
+
 func (self *StatusController) GetOnRenderToMain() func() error {
 	return func() error {
 		dashboardString := strings.Join(
@@ -40,7 +40,7 @@ func (self *SubCommitsController) GetOnRenderToMain() func() error {
 			} else {
 				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Sha, self.c.Modes().Filtering.GetPath())
 
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = types.NewRunPtyDiffTask(cmdObj.GetCmd())
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
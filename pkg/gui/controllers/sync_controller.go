@@ -8,6 +8,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -42,6 +43,22 @@ func (self *SyncController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			GetDisabledReason: self.getDisabledReasonForPushOrPull,
 			Description:       self.c.Tr.Pull,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.PullMenu),
+			Handler:           opts.Guards.NoPopupPanel(self.HandlePullMenu),
+			GetDisabledReason: self.getDisabledReasonForPushOrPull,
+			Description:       self.c.Tr.ViewPullMenu,
+			Tooltip:           self.c.Tr.ViewPullMenuTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.PushMenu),
+			Handler:           opts.Guards.NoPopupPanel(self.HandlePushMenu),
+			GetDisabledReason: self.getDisabledReasonForPushOrPull,
+			Description:       self.c.Tr.ViewPushMenu,
+			Tooltip:           self.c.Tr.ViewPushMenuTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -55,10 +72,18 @@ func (self *SyncController) HandlePush() error {
 	return self.branchCheckedOut(self.push)()
 }
 
+func (self *SyncController) HandlePushMenu() error {
+	return self.branchCheckedOut((&PushMenuAction{c: self.c}).Call)()
+}
+
 func (self *SyncController) HandlePull() error {
 	return self.branchCheckedOut(self.pull)()
 }
 
+func (self *SyncController) HandlePullMenu() error {
+	return self.branchCheckedOut(self.openPullMenu)()
+}
+
 func (self *SyncController) getDisabledReasonForPushOrPull() string {
 	currentBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 	if currentBranch != nil {
@@ -113,7 +138,11 @@ func (self *SyncController) push(currentBranch *models.Branch) error {
 }
 
 func (self *SyncController) pull(currentBranch *models.Branch) error {
-	action := self.c.Tr.Actions.Pull
+	return self.pullWithStrategy(currentBranch, self.c.Git().Sync.GetPullStrategy(currentBranch.Name))
+}
+
+func (self *SyncController) pullWithStrategy(currentBranch *models.Branch, strategy git_commands.PullStrategy) error {
+	opts := PullFilesOptions{Action: self.c.Tr.Actions.Pull, Strategy: strategy}
 
 	// if we have no upstream branch we need to set that first
 	if !currentBranch.IsTrackingRemote() {
@@ -122,11 +151,11 @@ func (self *SyncController) pull(currentBranch *models.Branch) error {
 				return self.c.Error(err)
 			}
 
-			return self.PullAux(currentBranch, PullFilesOptions{Action: action})
+			return self.PullAux(currentBranch, opts)
 		})
 	}
 
-	return self.PullAux(currentBranch, PullFilesOptions{Action: action})
+	return self.PullAux(currentBranch, opts)
 }
 
 func (self *SyncController) setCurrentBranchUpstream(upstream string) error {
@@ -147,20 +176,61 @@ func (self *SyncController) setCurrentBranchUpstream(upstream string) error {
 	return nil
 }
 
+// Lets you choose how to pull the current branch. The choice is remembered for
+// the branch, so that pulling it later uses the same strategy.
+func (self *SyncController) openPullMenu(currentBranch *models.Branch) error {
+	rememberedStrategy := self.c.Git().Sync.GetPullStrategy(currentBranch.Name)
+
+	menuItem := func(label string, strategy git_commands.PullStrategy, tooltip string, key types.Key) *types.MenuItem {
+		remembered := ""
+		if strategy == rememberedStrategy {
+			remembered = style.FgGreen.Sprint(self.c.Tr.RememberedPullStrategy)
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{label, remembered},
+			OnPress: func() error {
+				if strategy != rememberedStrategy {
+					self.c.LogAction(self.c.Tr.Actions.SetPullStrategy)
+					if err := self.c.Git().Sync.SetPullStrategy(currentBranch.Name, strategy); err != nil {
+						return self.c.Error(err)
+					}
+				}
+
+				return self.pullWithStrategy(currentBranch, strategy)
+			},
+			Key:     key,
+			Tooltip: tooltip,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: fmt.Sprintf(self.c.Tr.PullMenuTitle, currentBranch.Name),
+		Items: []*types.MenuItem{
+			menuItem(self.c.Tr.PullMerge, git_commands.PullStrategyMerge, self.c.Tr.PullMergeTooltip, 'm'),
+			menuItem(self.c.Tr.PullRebase, git_commands.PullStrategyRebase, self.c.Tr.PullRebaseTooltip, 'r'),
+			menuItem(self.c.Tr.PullRebaseAutostash, git_commands.PullStrategyRebaseAutostash, self.c.Tr.PullRebaseAutostashTooltip, 's'),
+			menuItem(self.c.Tr.PullFastForwardOnly, git_commands.PullStrategyFastForwardOnly, self.c.Tr.PullFastForwardOnlyTooltip, 'f'),
+			menuItem(self.c.Tr.PullGitConfigDefault, git_commands.PullStrategyDefault, self.c.Tr.PullGitConfigDefaultTooltip, 'd'),
+		},
+	})
+}
+
 type PullFilesOptions struct {
 	UpstreamRemote  string
 	UpstreamBranch  string
 	FastForwardOnly bool
+	Strategy        git_commands.PullStrategy
 	Action          string
 }
 
 func (self *SyncController) PullAux(currentBranch *models.Branch, opts PullFilesOptions) error {
 	return self.c.WithInlineStatus(currentBranch, types.ItemOperationPulling, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
-		return self.pullWithLock(task, opts)
+		return self.pullWithLock(task, currentBranch, opts)
 	})
 }
 
-func (self *SyncController) pullWithLock(task gocui.Task, opts PullFilesOptions) error {
+func (self *SyncController) pullWithLock(task gocui.Task, currentBranch *models.Branch, opts PullFilesOptions) error {
 	self.c.LogAction(opts.Action)
 
 	err := self.c.Git().Sync.Pull(
@@ -169,10 +239,11 @@ func (self *SyncController) pullWithLock(task gocui.Task, opts PullFilesOptions)
 			RemoteName:      opts.UpstreamRemote,
 			BranchName:      opts.UpstreamBranch,
 			FastForwardOnly: opts.FastForwardOnly,
+			Strategy:        opts.Strategy,
 		},
 	)
 
-	return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+	return self.c.Helpers().MergeAndRebase.CheckPullResult(err, currentBranch.Name)
 }
 
 type pushOpts struct {
@@ -1,11 +1,18 @@
 package controllers
 
 import (
+	"strings"
+	"time"
+
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagsController struct {
@@ -48,11 +55,25 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Description: self.c.Tr.CreateTag,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Commits.ViewResetOptions),
-			Handler:     self.withSelectedTag(self.createResetMenu),
-			Description: self.c.Tr.ViewResetOptions,
+			Key:         opts.GetKey(opts.Config.Branches.CreateSignedTag),
+			Handler:     self.createSigned,
+			Description: self.c.Tr.CreateSignedTag,
+			Tooltip:     self.c.Tr.CreateSignedTagTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CompareTagsWithRemote),
+			Handler:     self.compareWithRemote,
+			Description: self.c.Tr.CompareTagsWithRemote,
+			Tooltip:     self.c.Tr.CompareTagsWithRemoteTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:          opts.GetKey(opts.Config.Commits.ViewResetOptions),
+			Handler:      self.withSelectedTag(self.createResetMenu),
+			Description:  self.c.Tr.ViewResetOptions,
+			OpensMenu:    true,
+			GetMenuItems: self.resetMenuItems,
+		},
 	}
 
 	return bindings
@@ -62,12 +83,21 @@ func (self *TagsController) GetOnRenderToMain() func() error {
 	return func() error {
 		return self.c.Helpers().Diff.WithDiffModeCheck(func() error {
 			var task types.UpdateTask
+			var secondary *types.ViewUpdateOpts
 			tag := self.context().GetSelected()
 			if tag == nil {
 				task = types.NewRenderStringTask("No tags")
 			} else {
 				cmdObj := self.c.Git().Branch.GetGraphCmdObj(tag.FullRefName())
 				task = types.NewRunCommandTask(cmdObj.GetCmd())
+
+				// lightweight tags have no metadata of their own
+				if tag.IsAnnotated {
+					secondary = &types.ViewUpdateOpts{
+						Title: self.c.Tr.TagDetailsTitle,
+						Task:  self.tagDetailsTask(tag),
+					}
+				}
 			}
 
 			return self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -76,11 +106,40 @@ func (self *TagsController) GetOnRenderToMain() func() error {
 					Title: "Tag",
 					Task:  task,
 				},
+				Secondary: secondary,
 			})
 		})
 	}
 }
 
+// Verifying a signature can take a while (e.g. when gpg needs to look up the
+// key), so for signed tags the output of verify-tag is streamed in below the
+// details rather than being awaited here.
+func (self *TagsController) tagDetailsTask(tag *models.Tag) types.UpdateTask {
+	signature := style.FgYellow.Sprint(self.c.Tr.TagNotSigned)
+	if tag.IsSigned {
+		signature = style.FgBlue.Sprint(self.c.Tr.TagSigned)
+	}
+
+	lines, _ := utils.RenderDisplayStrings(
+		[][]string{
+			{style.FgCyan.Sprint(self.c.Tr.TaggerLabel + ":"), tag.Tagger},
+			{style.FgCyan.Sprint(self.c.Tr.TagDateLabel + ":"), time.Unix(tag.Date, 0).Format(self.c.UserConfig.Gui.TimeFormat)},
+			{style.FgCyan.Sprint(self.c.Tr.TagTargetLabel + ":"), tag.TargetType},
+			{style.FgCyan.Sprint(self.c.Tr.TagSignatureLabel + ":"), signature},
+		},
+		nil,
+	)
+
+	details := strings.Join(lines, "\n")
+	if !tag.IsSigned {
+		return types.NewRenderStringTask(details)
+	}
+
+	cmdObj := self.c.Git().Tag.VerifyCmdObj(tag.Name)
+	return types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), details+"\n\n")
+}
+
 func (self *TagsController) checkout(tag *models.Tag) error {
 	self.c.LogAction(self.c.Tr.Actions.CheckoutTag)
 	if err := self.c.Helpers().Refs.CheckoutRef(tag.Name, types.CheckoutRefOptions{}); err != nil {
@@ -92,7 +151,11 @@ func (self *TagsController) checkout(tag *models.Tag) error {
 func (self *TagsController) localDelete(tag *models.Tag) error {
 	return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
+		objectShas := self.c.Helpers().Journal.TagObjectShas([]string{tag.Name})
 		err := self.c.Git().Tag.LocalDelete(tag.Name)
+		if err == nil {
+			self.c.Helpers().Journal.RecordTagDeletion([]string{tag.Name}, objectShas)
+		}
 		_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 		return err
 	})
@@ -208,11 +271,191 @@ func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name)
 }
 
+func (self *TagsController) resetMenuItems() []*types.MenuItem {
+	tag := self.context().GetSelected()
+	if tag == nil {
+		return nil
+	}
+
+	return self.c.Helpers().Refs.GitResetMenuItems(tag.Name)
+}
+
 func (self *TagsController) create() error {
 	// leaving commit SHA blank so that we're just creating the tag for the current commit
 	return self.c.Helpers().Tags.OpenCreateTagPrompt("", func() { self.context().SetSelectedLineIdx(0) })
 }
 
+func (self *TagsController) createSigned() error {
+	return self.c.Helpers().Tags.OpenCreateSignedTagPrompt("", func() { self.context().SetSelectedLineIdx(0) })
+}
+
+func (self *TagsController) compareWithRemote() error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.SelectRemoteToCompareTags,
+		InitialContent:      "origin",
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.ComparingTagsStatus, func(gocui.Task) error {
+				syncInfos, err := self.c.Git().Tag.CompareWithRemote(remoteName)
+				if err != nil {
+					return err
+				}
+
+				if len(syncInfos) == 0 {
+					self.c.Toast(self.c.Tr.TagsInSync)
+					return nil
+				}
+
+				self.c.OnUIThread(func() error {
+					return self.showTagSyncChecklist(remoteName, syncInfos)
+				})
+				return nil
+			})
+		},
+	})
+}
+
+func (self *TagsController) showTagSyncChecklist(remoteName string, syncInfos []*git_commands.TagSyncInfo) error {
+	statusByName := map[string]git_commands.TagSyncStatus{}
+	items := lo.Map(syncInfos, func(info *git_commands.TagSyncInfo, _ int) *helpers.ChecklistItem {
+		statusByName[info.Name] = info.Status
+		return &helpers.ChecklistItem{
+			Label:       info.Name,
+			Description: self.tagSyncStatusText(info.Status),
+		}
+	})
+
+	return self.c.Helpers().Checklist.Show(helpers.ChecklistOpts{
+		Title:        utils.ResolvePlaceholderString(self.c.Tr.TagSyncTitle, map[string]string{"remoteName": remoteName}),
+		Items:        items,
+		ConfirmLabel: self.c.Tr.SyncSelectedTags,
+		HandleConfirm: func(checked []*helpers.ChecklistItem) error {
+			// each action only applies to the selected tags with the given statuses
+			tagsWithStatus := func(statuses ...git_commands.TagSyncStatus) []string {
+				return lo.FilterMap(checked, func(item *helpers.ChecklistItem, _ int) (string, bool) {
+					return item.Label, lo.Contains(statuses, statusByName[item.Label])
+				})
+			}
+
+			return self.showTagSyncMenu(
+				remoteName,
+				tagsWithStatus(git_commands.TagLocalOnly, git_commands.TagDiffers),
+				tagsWithStatus(git_commands.TagRemoteOnly, git_commands.TagDiffers),
+				len(tagsWithStatus(git_commands.TagDiffers)) > 0,
+			)
+		},
+	})
+}
+
+func (self *TagsController) tagSyncStatusText(status git_commands.TagSyncStatus) string {
+	switch status {
+	case git_commands.TagLocalOnly:
+		return style.FgGreen.Sprint(self.c.Tr.TagLocalOnly)
+	case git_commands.TagRemoteOnly:
+		return style.FgRed.Sprint(self.c.Tr.TagRemoteOnly)
+	default:
+		return style.FgYellow.Sprint(self.c.Tr.TagDiffers)
+	}
+}
+
+func (self *TagsController) showTagSyncMenu(remoteName string, localTags []string, remoteTags []string, force bool) error {
+	remoteArgs := map[string]string{"remoteName": remoteName}
+	disabledReasonIfEmpty := func(tagNames []string) string {
+		if len(tagNames) == 0 {
+			return self.c.Tr.NoApplicableTagsSelected
+		}
+		return ""
+	}
+	refreshTags := func() error {
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.TagSyncTitle, remoteArgs),
+		Items: []*types.MenuItem{
+			{
+				Label:   utils.ResolvePlaceholderString(self.c.Tr.PushTagsToRemote, remoteArgs),
+				Tooltip: self.c.Tr.PushTagsToRemoteTooltip,
+				Key:     'p',
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.PushingTagStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.PushTags)
+						if err := self.c.Git().Tag.PushMultiple(task, remoteName, localTags, force); err != nil {
+							return err
+						}
+						return refreshTags()
+					})
+				},
+				DisabledReason: disabledReasonIfEmpty(localTags),
+			},
+			{
+				Label:   utils.ResolvePlaceholderString(self.c.Tr.FetchTagsFromRemote, remoteArgs),
+				Tooltip: self.c.Tr.FetchTagsFromRemoteTooltip,
+				Key:     'f',
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.FetchTags)
+						if err := self.c.Git().Tag.FetchMultiple(task, remoteName, remoteTags); err != nil {
+							return err
+						}
+						return refreshTags()
+					})
+				},
+				DisabledReason: disabledReasonIfEmpty(remoteTags),
+			},
+			{
+				Label:   utils.ResolvePlaceholderString(self.c.Tr.DeleteTagsFromRemote, remoteArgs),
+				Tooltip: self.c.Tr.DeleteTagsFromRemoteTooltip,
+				Key:     'r',
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title: self.c.Tr.DeleteRemoteTag,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.DeleteRemoteTagsPrompt, map[string]string{
+							"remoteName": remoteName,
+							"tags":       strings.Join(remoteTags, "\n"),
+						}),
+						HandleConfirm: func() error {
+							return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+								self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTags)
+								if err := self.c.Git().Remote.DeleteRemoteTags(task, remoteName, remoteTags); err != nil {
+									return err
+								}
+								return refreshTags()
+							})
+						},
+					})
+				},
+				DisabledReason: disabledReasonIfEmpty(remoteTags),
+			},
+			{
+				Label:   self.c.Tr.DeleteTagsLocally,
+				Tooltip: self.c.Tr.DeleteTagsLocallyTooltip,
+				Key:     'd',
+				OnPress: func() error {
+					return self.c.Confirm(types.ConfirmOpts{
+						Title: self.c.Tr.DeleteLocalTag,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.DeleteLocalTagsPrompt, map[string]string{
+							"tags": strings.Join(localTags, "\n"),
+						}),
+						HandleConfirm: func() error {
+							return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
+								self.c.LogAction(self.c.Tr.Actions.DeleteLocalTags)
+								objectShas := self.c.Helpers().Journal.TagObjectShas(localTags)
+								if err := self.c.Git().Tag.LocalDeleteMultiple(localTags); err != nil {
+									return err
+								}
+								self.c.Helpers().Journal.RecordTagDeletion(localTags, objectShas)
+								return refreshTags()
+							})
+						},
+					})
+				},
+				DisabledReason: disabledReasonIfEmpty(localTags),
+			},
+		},
+	})
+}
+
 func (self *TagsController) withSelectedTag(f func(tag *models.Tag) error) func() error {
 	return func() error {
 		tag := self.context().GetSelected()
@@ -4,9 +4,12 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/journal"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 // Quick summary of how this all works:
@@ -18,6 +21,9 @@ import (
 // actions we can skip. E.g. if I do do three things, A, B, and C, and hit undo twice,
 // the reflog will read UUCBA, and when I read the first two undos, I know to skip the following
 // two user actions, meaning we end up undoing reflog entry C. Redoing works in a similar way.
+// Operations that don't show up in the reflog (e.g. deleting a branch) are recorded in the
+// journal instead, together with the reflog entry that was newest at the time. That tells
+// us whether a journal entry or a reflog entry comes next when undoing or redoing.
 
 type UndoController struct {
 	baseController
@@ -48,6 +54,8 @@ type reflogAction struct {
 	kind ReflogActionKind
 	from string
 	to   string
+	// the index of the action's reflog entry, the newest entry being 0
+	reflogIdx int
 }
 
 func (self *UndoController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
@@ -81,11 +89,20 @@ func (self *UndoController) reflogUndo() error {
 		return self.c.ErrorMsg(self.c.Tr.CantUndoWhileRebasing)
 	}
 
-	return self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
+	journalEntry := self.c.Helpers().Journal.Journal().NextToUndo()
+	journalEntryIdx := self.reflogIdxOfJournalEntry(journalEntry)
+
+	handled := false
+	err := self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		if counter != 0 {
 			return false, nil
 		}
 
+		handled = true
+		if journalEntry != nil && action.reflogIdx >= journalEntryIdx {
+			return true, self.undoJournalEntry(journalEntry)
+		}
+
 		switch action.kind {
 		case COMMIT, REBASE:
 			return true, self.c.Confirm(types.ConfirmOpts{
@@ -119,6 +136,10 @@ func (self *UndoController) reflogUndo() error {
 		self.c.Log.Error("didn't match on the user action when trying to undo")
 		return true, nil
 	})
+	if !handled && journalEntry != nil {
+		return self.undoJournalEntry(journalEntry)
+	}
+	return err
 }
 
 func (self *UndoController) reflogRedo() error {
@@ -129,14 +150,24 @@ func (self *UndoController) reflogRedo() error {
 		return self.c.ErrorMsg(self.c.Tr.CantRedoWhileRebasing)
 	}
 
-	return self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
-		// if we're redoing and the counter is zero, we just return
+	journalEntry := self.c.Helpers().Journal.Journal().NextToRedo()
+	journalEntryIdx := self.reflogIdxOfJournalEntry(journalEntry)
+
+	handled := false
+	err := self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
+		// if we're redoing and the counter is zero, there's nothing to redo in the reflog
 		if counter == 0 {
 			return true, nil
 		} else if counter > 1 {
 			return false, nil
 		}
 
+		// the journal entry was undone last if it's older than the reflog action
+		handled = true
+		if journalEntry != nil && action.reflogIdx < journalEntryIdx {
+			return true, self.redoJournalEntry(journalEntry)
+		}
+
 		switch action.kind {
 		case COMMIT, REBASE:
 			return true, self.c.Confirm(types.ConfirmOpts{
@@ -170,6 +201,74 @@ func (self *UndoController) reflogRedo() error {
 		self.c.Log.Error("didn't match on the user action when trying to redo")
 		return true, nil
 	})
+	if !handled && journalEntry != nil {
+		return self.redoJournalEntry(journalEntry)
+	}
+	return err
+}
+
+// Returns the index of the reflog entry that was newest when the journal
+// entry was recorded. If we can't find it (e.g. because we're filtering by
+// path) we assume that the journal entry is newer than the whole reflog.
+func (self *UndoController) reflogIdxOfJournalEntry(entry *journal.Entry) int {
+	if entry == nil {
+		return 0
+	}
+
+	reflogCommits := self.c.Model().FilteredReflogCommits
+	if entry.ReflogHead == nil {
+		return len(reflogCommits)
+	}
+
+	_, idx, found := lo.FindIndexOf(reflogCommits, func(commit *models.Commit) bool {
+		return commit.Sha == entry.ReflogHead.Sha &&
+			commit.Name == entry.ReflogHead.Name &&
+			commit.UnixTimestamp == entry.ReflogHead.UnixTimestamp
+	})
+	if !found {
+		return 0
+	}
+	return idx
+}
+
+func (self *UndoController) undoJournalEntry(entry *journal.Entry) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Actions.Undo,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.UndoJournalEntryPrompt, map[string]string{"operation": entry.Description}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.UndoingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.Undo)
+				err := entry.Undo()
+				if err == nil {
+					self.c.Helpers().Journal.Journal().SetUndone(entry, true)
+				}
+				if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); refreshErr != nil {
+					return refreshErr
+				}
+				return err
+			})
+		},
+	})
+}
+
+func (self *UndoController) redoJournalEntry(entry *journal.Entry) error {
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Actions.Redo,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RedoJournalEntryPrompt, map[string]string{"operation": entry.Description}),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.RedoingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.Redo)
+				err := entry.Redo()
+				if err == nil {
+					self.c.Helpers().Journal.Journal().SetUndone(entry, false)
+				}
+				if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); refreshErr != nil {
+					return refreshErr
+				}
+				return err
+			})
+		},
+	})
 }
 
 // Here we're going through the reflog and maintaining a counter that represents how many
@@ -216,6 +315,7 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 				// if we're going from one place to the same place we'll ignore the action.
 				continue
 			}
+			action.reflogIdx = reflogCommitIdx
 			ok, err := onUserAction(counter, *action)
 			if ok {
 				return err
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 // this is in its own file given that the workspace controller file is already quite long
@@ -30,6 +32,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
+				if err := self.c.Helpers().DiscardedChanges.SnapshotAll(self.c.Tr.Actions.NukeWorkingTree); err != nil {
+					return self.c.Error(err)
+				}
 				if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
 					return self.c.Error(err)
 				}
@@ -52,6 +57,11 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedFileChanges)
+				if err := self.snapshotFiles(self.c.Tr.Actions.DiscardUnstagedFileChanges, func(file *models.File) bool {
+					return file.Tracked && file.HasUnstagedChanges
+				}); err != nil {
+					return self.c.Error(err)
+				}
 				if err := self.c.Git().WorkingTree.DiscardAnyUnstagedFileChanges(); err != nil {
 					return self.c.Error(err)
 				}
@@ -69,6 +79,11 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.RemoveUntrackedFiles)
+				if err := self.snapshotFiles(self.c.Tr.Actions.RemoveUntrackedFiles, func(file *models.File) bool {
+					return !file.Tracked
+				}); err != nil {
+					return self.c.Error(err)
+				}
 				if err := self.c.Git().WorkingTree.RemoveUntrackedFiles(); err != nil {
 					return self.c.Error(err)
 				}
@@ -90,6 +105,11 @@ func (self *FilesController) createResetMenu() error {
 				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 					return self.c.ErrorMsg(self.c.Tr.NoTrackedStagedFilesStash)
 				}
+				if err := self.snapshotFiles(self.c.Tr.Actions.RemoveStagedFiles, func(file *models.File) bool {
+					return file.HasStagedChanges
+				}); err != nil {
+					return self.c.Error(err)
+				}
 				if err := self.c.Git().Stash.SaveStagedChanges("[lazygit] tmp stash"); err != nil {
 					return self.c.Error(err)
 				}
@@ -144,6 +164,11 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.HardReset)
+				if err := self.snapshotFiles(self.c.Tr.Actions.HardReset, func(file *models.File) bool {
+					return file.Tracked
+				}); err != nil {
+					return self.c.Error(err)
+				}
 				if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
 					return self.c.Error(err)
 				}
@@ -154,11 +179,30 @@ func (self *FilesController) createResetMenu() error {
 			},
 			Key: 'h',
 		},
+		{
+			LabelColumns: []string{self.c.Tr.RecoverDiscardedChanges, ""},
+			OnPress:      self.c.Helpers().DiscardedChanges.OpenRecoveryMenu,
+			Key:          'r',
+			Tooltip:      self.c.Tr.RecoverDiscardedChangesTooltip,
+			OpensMenu:    true,
+		},
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{Title: "", Items: menuItems})
 }
 
+// Snapshots the files matching the test before discarding their changes, so
+// that they can be recovered
+func (self *FilesController) snapshotFiles(action string, test func(file *models.File) bool) error {
+	paths := lo.FlatMap(lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		return test(file)
+	}), func(file *models.File, _ int) []string {
+		return file.Names()
+	})
+	_, err := self.c.Helpers().DiscardedChanges.Snapshot(action, paths)
+	return err
+}
+
 func (self *FilesController) animateExplosion() {
 	self.Explode(self.c.Views().Files, func() {
 		err := self.c.PostRefreshUpdate(self.c.Contexts().Files)
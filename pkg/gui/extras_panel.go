@@ -32,6 +32,11 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label:   gui.c.Tr.FocusCommandLog,
 				OnPress: gui.handleFocusCommandLog,
 			},
+			{
+				Label:     gui.c.Tr.ViewAuditLog,
+				OnPress:   gui.helpers.AuditLog.OpenSessionsMenu,
+				OpensMenu: true,
+			},
 		},
 	})
 }
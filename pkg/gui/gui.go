@@ -7,10 +7,12 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
+	"github.com/jesseduffield/lazygit/pkg/auditlog"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
@@ -20,6 +22,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/journal"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/cherrypicking"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
@@ -31,13 +34,13 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/services/plugins"
 	"github.com/jesseduffield/lazygit/pkg/gui/status"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/integration/components"
 	integrationTypes "github.com/jesseduffield/lazygit/pkg/integration/types"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
-	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/updates"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
@@ -64,6 +67,14 @@ type Gui struct {
 
 	CustomCommandsClient *custom_commands.Client
 
+	// unlike the custom commands client, this is kept when switching repos so
+	// that plugins don't get restarted
+	PluginsClient *plugins.Client
+
+	// keybindings are reset whenever a menu is opened, so we remember which
+	// chord conflicts we've told the user about already
+	reportedChordConflicts map[string]bool
+
 	// this is a mapping of repos to gui states, so that we can restore the original
 	// gui state when returning from a subrepo.
 	// In repos with multiple worktrees, we store a separate repo state per worktree.
@@ -97,6 +108,10 @@ type Gui struct {
 	// Log of the commands/actions logged in the Command Log panel.
 	GuiLog []string
 
+	// Records the logged actions and commands on disk, unlike GuiLog it's kept
+	// across sessions
+	AuditLog *auditlog.Logger
+
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
@@ -241,6 +256,9 @@ type GuiRepoState struct {
 	ScreenMode types.WindowMaximisation
 
 	CurrentPopupOpts *types.CreatePopupPanelOpts
+
+	// Operations that can be undone in addition to the ones in the reflog
+	Journal *journal.Journal
 }
 
 var _ types.IRepoStateAccessor = new(GuiRepoState)
@@ -293,6 +311,16 @@ func (self *GuiRepoState) GetSplitMainPanel() bool {
 	return self.SplitMainPanel
 }
 
+func (self *GuiRepoState) GetJournal() *journal.Journal {
+	return self.Journal
+}
+
+// The plugins' keybindings may introduce new conflicts
+func (gui *Gui) onPluginsStarted() error {
+	gui.helpers.Keybindings.Invalidate()
+	return gui.resetKeybindings()
+}
+
 func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.ContextKey) error {
 	var err error
 	gui.git, err = commands.NewGitCommand(
@@ -313,6 +341,8 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 		return err
 	}
 
+	gui.PluginsClient.OnRepoChanged()
+
 	gui.g.SetFocusHandler(func(Focused bool) error {
 		if Focused {
 			gui.c.Log.Info("Receiving focus - refreshing")
@@ -397,6 +427,7 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 		Contexts:          contextTree,
 		WindowViewNameMap: initialWindowViewNameMap(contextTree),
 		SearchState:       types.NewSearchState(),
+		Journal:           journal.New(),
 	}
 
 	gui.RepoStateMap[Repo(worktreePath)] = gui.State
@@ -480,6 +511,7 @@ func NewGui(
 		RepoPathStack:        &utils.StringStack{},
 		RepoStateMap:         map[Repo]*GuiRepoState{},
 		GuiLog:               []string{},
+		AuditLog:             newAuditLog(cmn, config),
 
 		// originally we could only hide the command log permanently via the config
 		// but now we do it via state. So we need to still support the config for the
@@ -514,6 +546,7 @@ func NewGui(
 		gui.createMenu,
 		func(message string, f func(gocui.Task) error) { gui.helpers.AppStatus.WithWaitingStatus(message, f) },
 		func(message string) { gui.helpers.AppStatus.Toast(message) },
+		gui.LogCommand,
 		func() string { return gui.Views.Confirmation.TextArea.GetContent() },
 		func() bool { return gui.c.InDemo() },
 	)
@@ -526,6 +559,7 @@ func NewGui(
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
 		gui.LogCommand,
+		gui.AuditLog.LogCommand,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -687,6 +721,9 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 		return err
 	}
 
+	gui.PluginsClient.Start(gui.onPluginsStarted)
+	defer gui.PluginsClient.Stop()
+
 	gui.waitForIntro.Add(1)
 
 	gui.BackgroundRoutineMgr.startBackgroundRoutines()
@@ -812,7 +849,9 @@ func (gui *Gui) runSubprocess(cmdObj oscommands.ICmdObj) error { //nolint:unpara
 
 	fmt.Fprintf(os.Stdout, "\n%s\n\n", style.FgBlue.Sprint("+ "+strings.Join(subprocess.Args, " ")))
 
+	t := time.Now()
 	err := subprocess.Run()
+	gui.AuditLog.LogCommand(cmdObj.ToString(), oscommands.ExitCode(err), time.Since(t))
 
 	subprocess.Stdout = io.Discard
 	subprocess.Stderr = io.Discard
@@ -866,14 +905,13 @@ func (gui *Gui) showIntroPopupMessage() {
 }
 
 // setColorScheme sets the color scheme for the app based on the user config
+// and the theme picked in the themes menu. The helpers don't exist yet at this
+// point, hence the one-off theme helper.
 func (gui *Gui) setColorScheme() error {
-	userConfig := gui.UserConfig
-	theme.UpdateTheme(userConfig.Gui.Theme)
-
-	gui.g.FgColor = theme.InactiveBorderColor
-	gui.g.SelFgColor = theme.ActiveBorderColor
-	gui.g.FrameColor = theme.InactiveBorderColor
-	gui.g.SelFrameColor = theme.ActiveBorderColor
+	if err := helpers.NewThemeHelper(gui.c).ApplyTheme(); err != nil {
+		gui.c.Log.Error(err)
+		gui.c.OnUIThread(func() error { return gui.c.Error(err) })
+	}
 
 	return nil
 }
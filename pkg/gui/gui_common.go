@@ -184,6 +184,10 @@ func (self *guiCommon) GetInitialKeybindingsWithCustomCommands() ([]*types.Bindi
 	return self.gui.GetInitialKeybindingsWithCustomCommands()
 }
 
+func (self *guiCommon) GetDefaultKeybindings() []*types.Binding {
+	return self.gui.GetDefaultKeybindings()
+}
+
 func (self *guiCommon) AfterLayout(f func() error) {
 	select {
 	case self.gui.afterLayoutFuncs <- f:
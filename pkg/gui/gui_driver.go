@@ -51,6 +51,12 @@ func (self *GuiDriver) Click(x, y int) {
 		0,
 	)
 	self.waitTillIdle()
+	// release the button, so that the next click isn't taken for a drag
+	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
+		tcell.NewEventMouse(x, y, tcell.ButtonNone, 0),
+		0,
+	)
+	self.waitTillIdle()
 }
 
 // wait until lazygit is idle (i.e. all processing is done) before continuing
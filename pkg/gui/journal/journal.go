@@ -0,0 +1,82 @@
+package journal
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// The journal records lazygit operations that can't be undone via the reflog,
+// e.g. deleting a branch or discarding changes, together with what's needed to
+// undo and redo them. Undo and redo walk the journal and the reflog in
+// chronological order.
+type Journal struct {
+	entries []*Entry
+	mutex   deadlock.Mutex
+}
+
+type Entry struct {
+	// The newest reflog entry at the time the operation was performed, or nil
+	// if the reflog was empty. This places the operation between the entries
+	// of the reflog; we can't use timestamps for that because the reflog only
+	// gives us the timestamps of the commits.
+	ReflogHead *models.Commit
+	// E.g. "Delete branch: feature"
+	Description string
+	Undo        func() error
+	Redo        func() error
+
+	undone bool
+}
+
+// Older entries are dropped, because the objects they refer to are eventually
+// garbage collected by git anyway
+const maxEntries = 100
+
+func New() *Journal {
+	return &Journal{}
+}
+
+// Records an operation that was just performed. Operations that have been
+// undone can't be redone anymore afterwards, like in an editor.
+func (self *Journal) Record(entry *Entry) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries = append(lo.Filter(self.entries, func(entry *Entry, _ int) bool {
+		return !entry.undone
+	}), entry)
+	if len(self.entries) > maxEntries {
+		self.entries = self.entries[len(self.entries)-maxEntries:]
+	}
+}
+
+// Returns the most recent entry that hasn't been undone, or nil
+func (self *Journal) NextToUndo() *Entry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry, _ := lo.Last(lo.Filter(self.entries, func(entry *Entry, _ int) bool {
+		return !entry.undone
+	}))
+	return entry
+}
+
+// Returns the entry that was undone last, or nil. Entries are undone from the
+// most recent one backwards, so that's the oldest entry that has been undone.
+func (self *Journal) NextToRedo() *Entry {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry, _ := lo.Find(self.entries, func(entry *Entry) bool {
+		return entry.undone
+	})
+	return entry
+}
+
+func (self *Journal) SetUndone(entry *Entry, undone bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	entry.undone = undone
+}
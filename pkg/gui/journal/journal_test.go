@@ -0,0 +1,52 @@
+package journal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal(t *testing.T) {
+	journal := New()
+	assert.Nil(t, journal.NextToUndo())
+	assert.Nil(t, journal.NextToRedo())
+
+	a := &Entry{Description: "a"}
+	b := &Entry{Description: "b"}
+	c := &Entry{Description: "c"}
+	journal.Record(a)
+	journal.Record(b)
+	journal.Record(c)
+
+	// undoing goes backwards, redoing forwards again
+	assert.Equal(t, c, journal.NextToUndo())
+	journal.SetUndone(c, true)
+	assert.Equal(t, b, journal.NextToUndo())
+	journal.SetUndone(b, true)
+	assert.Equal(t, a, journal.NextToUndo())
+	assert.Equal(t, b, journal.NextToRedo())
+	journal.SetUndone(b, false)
+	assert.Equal(t, c, journal.NextToRedo())
+
+	// a new operation discards the ones that could be redone
+	d := &Entry{Description: "d"}
+	journal.Record(d)
+	assert.Nil(t, journal.NextToRedo())
+	assert.Equal(t, d, journal.NextToUndo())
+	journal.SetUndone(d, true)
+	assert.Equal(t, b, journal.NextToUndo())
+}
+
+func TestJournalDropsOldEntries(t *testing.T) {
+	journal := New()
+	first := &Entry{Description: "first"}
+	journal.Record(first)
+	for i := 0; i < maxEntries; i++ {
+		journal.Record(&Entry{})
+	}
+
+	for entry := journal.NextToUndo(); entry != nil; entry = journal.NextToUndo() {
+		assert.NotEqual(t, first, entry)
+		journal.SetUndone(entry, true)
+	}
+}
@@ -2,11 +2,16 @@ package gui
 
 import (
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func (gui *Gui) noPopupPanel(f func() error) func() error {
@@ -73,10 +78,33 @@ func (self *Gui) keybindingOpts() types.KeybindingsOpts {
 	}
 }
 
+// The keybindings of the user config, including custom commands but not
+// plugins (which would have to be started first). Used for --print-keybindings.
+func (self *Gui) GetConfiguredKeybindings() ([]*types.Binding, error) {
+	bindings := self.GetCheatsheetKeybindings()
+	customBindings, err := self.CustomCommandsClient.GetCustomCommandKeybindings()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(customBindings, bindings...), nil
+}
+
+// The keybindings we'd have with the default keybinding config and no custom
+// commands. Used to tell which keybinding conflicts the user introduced.
+func (self *Gui) GetDefaultKeybindings() []*types.Binding {
+	opts := self.c.KeybindingsOpts()
+	opts.Config = config.GetDefaultConfig().Keybinding
+	bindings, _ := self.getKeybindings(opts)
+	return bindings
+}
+
 // renaming receiver to 'self' to aid refactoring. Will probably end up moving all Gui handlers to this pattern eventually.
 func (self *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBinding) {
-	opts := self.c.KeybindingsOpts()
+	return self.getKeybindings(self.c.KeybindingsOpts())
+}
 
+func (self *Gui) getKeybindings(opts types.KeybindingsOpts) ([]*types.Binding, []*gocui.ViewMouseBinding) {
 	bindings := []*types.Binding{
 		{
 			ViewName:    "",
@@ -330,8 +358,9 @@ func (self *Gui) GetInitialKeybindingsWithCustomCommands() ([]*types.Binding, []
 	if err != nil {
 		log.Fatal(err)
 	}
+	pluginBindings := self.PluginsClient.GetPluginKeybindings()
 	// prepending because we want to give our custom keybindings precedence over default keybindings
-	bindings = append(customBindings, bindings...)
+	bindings = append(append(customBindings, pluginBindings...), bindings...)
 	return bindings, mouseBindings
 }
 
@@ -340,6 +369,9 @@ func (gui *Gui) resetKeybindings() error {
 
 	bindings, mouseBindings := gui.GetInitialKeybindingsWithCustomCommands()
 
+	bindings, conflicts := keybindings.GroupChords(bindings, gui.openChordMenu)
+	gui.reportChordConflicts(conflicts)
+
 	for _, binding := range bindings {
 		if err := gui.SetKeybinding(binding); err != nil {
 			return err
@@ -368,6 +400,25 @@ func (gui *Gui) resetKeybindings() error {
 	return nil
 }
 
+// Chords that conflict with other keybindings are left out rather than failing
+// to set any keybindings; each conflict is shown once
+func (gui *Gui) reportChordConflicts(conflicts []error) {
+	if gui.reportedChordConflicts == nil {
+		gui.reportedChordConflicts = map[string]bool{}
+	}
+
+	for _, conflict := range conflicts {
+		msg := conflict.Error()
+		if gui.reportedChordConflicts[msg] {
+			continue
+		}
+		gui.reportedChordConflicts[msg] = true
+
+		gui.c.Log.Error(msg)
+		gui.c.OnUIThread(func() error { return gui.c.ErrorMsg(msg) })
+	}
+}
+
 func (gui *Gui) wrappedHandler(f func() error) func(g *gocui.Gui, v *gocui.View) error {
 	return func(g *gocui.Gui, v *gocui.View) error {
 		return f()
@@ -410,6 +461,69 @@ func (gui *Gui) SetMouseKeybinding(binding *gocui.ViewMouseBinding) error {
 	return gui.g.SetViewClickBinding(binding)
 }
 
+// Logs the keybinding conflicts introduced by the user config and points the
+// user to the keybindings menu, which lists them
+func (gui *Gui) reportKeybindingConflicts() {
+	conflicts := gui.helpers.Keybindings.Conflicts()
+	if len(conflicts) == 0 {
+		return
+	}
+
+	for _, conflict := range conflicts {
+		gui.c.Log.Warnf(
+			"Keybinding '%s' in view '%s': '%s' shadows '%s'",
+			keybindings.LabelFromKey(conflict.Key),
+			conflict.ViewName,
+			conflict.Effective.Description,
+			strings.Join(conflict.ShadowedDescriptions(), "', '"),
+		)
+	}
+
+	gui.c.Toast(utils.ResolvePlaceholderString(gui.c.Tr.KeybindingConflictsFound, map[string]string{
+		"count": strconv.Itoa(len(conflicts)),
+		"key":   keybindings.Label(gui.c.UserConfig.Keybinding.Universal.OptionMenu),
+	}))
+}
+
+// Shows which keys can follow the ones pressed so far
+func (gui *Gui) openChordMenu(node *keybindings.ChordNode) error {
+	menuItems := lo.Map(node.Children, func(child *keybindings.ChordNode, _ int) *types.MenuItem {
+		if child.Binding == nil {
+			return &types.MenuItem{
+				Label:     utils.ResolvePlaceholderString(gui.c.Tr.MoreKeybindingsStartingWith, map[string]string{"keys": keybindings.LabelFromKey(child.Prefix)}),
+				Key:       child.Key(),
+				OpensMenu: true,
+				OnPress:   func() error { return gui.openChordMenu(child) },
+			}
+		}
+
+		binding := child.Binding
+		disabledReason := ""
+		if binding.GetDisabledReason != nil {
+			disabledReason = binding.GetDisabledReason()
+		}
+
+		label := binding.Description
+		if label == "" {
+			label = keybindings.LabelFromKey(binding.Key)
+		}
+
+		return &types.MenuItem{
+			Label:          label,
+			Key:            child.Key(),
+			OpensMenu:      binding.OpensMenu,
+			Tooltip:        binding.Tooltip,
+			DisabledReason: disabledReason,
+			OnPress:        binding.Handler,
+		}
+	})
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: keybindings.LabelFromKey(node.Prefix),
+		Items: menuItems,
+	})
+}
+
 func (gui *Gui) callKeybindingHandler(binding *types.Binding) error {
 	disabledReason := ""
 	if binding.GetDisabledReason != nil {
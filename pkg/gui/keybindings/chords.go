@@ -0,0 +1,195 @@
+package keybindings
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// A sequence of keys that are pressed one after the other, e.g. 'g b'. Chords
+// are configured by separating the keys with spaces.
+type Chord []types.Key
+
+func getChord(keys []string) Chord {
+	return lo.Map(keys, func(key string, _ int) types.Key {
+		result := GetKey(key)
+		if result == nil {
+			log.Fatalf("Unrecognized key %s in keybinding '%s'. For permitted values see %s", key, keys, constants.Links.Docs.CustomKeybindings)
+		}
+		return result
+	})
+}
+
+// The keybindings that can follow after pressing the keys in Prefix. A node
+// either completes a chord, in which case Binding is set, or has children for
+// the keys that can be pressed next.
+type ChordNode struct {
+	Prefix   Chord
+	Binding  *types.Binding
+	Children []*ChordNode
+}
+
+// The key that was pressed to get to this node
+func (self *ChordNode) Key() types.Key {
+	return self.Prefix[len(self.Prefix)-1]
+}
+
+func (self *ChordNode) child(key types.Key) *ChordNode {
+	child, _ := lo.Find(self.Children, func(child *ChordNode) bool {
+		return LabelFromKey(child.Key()) == LabelFromKey(key)
+	})
+	return child
+}
+
+// Returns the binding that chord clashes with, if any: either a binding whose
+// chord is a prefix of this one, or one that this chord is a prefix of.
+func (self *ChordNode) conflictingBinding(chord Chord) *types.Binding {
+	node := self
+	for _, key := range chord[len(self.Prefix):] {
+		if node.Binding != nil {
+			return node.Binding
+		}
+		node = node.child(key)
+		if node == nil {
+			return nil
+		}
+	}
+
+	if len(node.Children) > 0 {
+		return firstBinding(node)
+	}
+
+	return nil
+}
+
+func firstBinding(node *ChordNode) *types.Binding {
+	if node.Binding != nil {
+		return node.Binding
+	}
+	return firstBinding(node.Children[0])
+}
+
+// Adds the binding to the tree. If there's already a binding for the same
+// chord, that one takes precedence, as with single-key bindings.
+func (self *ChordNode) add(chord Chord, binding *types.Binding) {
+	node := self
+	for i, key := range chord[len(self.Prefix):] {
+		child := node.child(key)
+		if child == nil {
+			child = &ChordNode{Prefix: chord[:len(self.Prefix)+i+1]}
+			node.Children = append(node.Children, child)
+		}
+		node = child
+	}
+
+	if node.Binding == nil {
+		node.Binding = binding
+	}
+}
+
+func (self *ChordNode) leaves() []*ChordNode {
+	if self.Binding != nil {
+		return []*ChordNode{self}
+	}
+	return lo.FlatMap(self.Children, func(child *ChordNode, _ int) []*ChordNode { return child.leaves() })
+}
+
+type chordRoot struct {
+	viewName string
+	firstKey string
+}
+
+// Replaces the bindings whose key is a chord with one binding per view and
+// first key, which calls openChord with the continuations of that key. It
+// takes the place of the first of those chords, so that precedence between
+// bindings is kept. Global chords are also offered in views that have chords
+// starting with the same key.
+//
+// Chords that start with a key that has a single-key binding in the same view,
+// or that are a prefix of another chord or have one as a prefix, could never
+// be reached (or would make another binding unreachable). They are left out,
+// and an error is returned for each of them so that the conflict can be
+// reported without losing all other keybindings.
+func GroupChords(bindings []*types.Binding, openChord func(*ChordNode) error) ([]*types.Binding, []error) {
+	singleKeyBindings := map[chordRoot]*types.Binding{}
+	for _, binding := range bindings {
+		if _, isChord := binding.Key.(Chord); !isChord && binding.Key != nil {
+			root := chordRoot{viewName: binding.ViewName, firstKey: LabelFromKey(binding.Key)}
+			if _, ok := singleKeyBindings[root]; !ok {
+				singleKeyBindings[root] = binding
+			}
+		}
+	}
+
+	result := make([]*types.Binding, 0, len(bindings))
+	conflicts := []error{}
+	roots := map[chordRoot]*ChordNode{}
+	rootOrder := []chordRoot{}
+	for _, binding := range bindings {
+		chord, isChord := binding.Key.(Chord)
+		if !isChord {
+			result = append(result, binding)
+			continue
+		}
+
+		root := chordRoot{viewName: binding.ViewName, firstKey: LabelFromKey(chord[0])}
+		if other, ok := singleKeyBindings[root]; ok {
+			conflicts = append(conflicts, conflictError(binding, other))
+			continue
+		}
+
+		node, ok := roots[root]
+		if !ok {
+			node = &ChordNode{Prefix: chord[:1]}
+			roots[root] = node
+			rootOrder = append(rootOrder, root)
+
+			result = append(result, &types.Binding{
+				ViewName:  binding.ViewName,
+				Key:       chord[0],
+				Modifier:  binding.Modifier,
+				Handler:   func() error { return openChord(node) },
+				OpensMenu: true,
+			})
+		}
+
+		if other := node.conflictingBinding(chord); other != nil {
+			conflicts = append(conflicts, conflictError(binding, other))
+			continue
+		}
+		node.add(chord, binding)
+	}
+
+	for _, root := range rootOrder {
+		if root.viewName == "" {
+			continue
+		}
+		globalNode, ok := roots[chordRoot{viewName: "", firstKey: root.firstKey}]
+		if !ok {
+			continue
+		}
+
+		// the view's own chords take precedence over clashing global ones
+		node := roots[root]
+		for _, leaf := range globalNode.leaves() {
+			chord := leaf.Binding.Key.(Chord)
+			if node.conflictingBinding(chord) == nil {
+				node.add(chord, leaf.Binding)
+			}
+		}
+	}
+
+	return result, conflicts
+}
+
+func conflictError(binding *types.Binding, other *types.Binding) error {
+	return fmt.Errorf(
+		"Keybinding '%s' (%s) conflicts with keybinding '%s' (%s) in the same view. A chord can't start with another keybinding. For more info see %s",
+		LabelFromKey(binding.Key), binding.Description,
+		LabelFromKey(other.Key), other.Description,
+		constants.Links.Docs.CustomKeybindings,
+	)
+}
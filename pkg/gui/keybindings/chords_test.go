@@ -0,0 +1,178 @@
+package keybindings
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKeyChord(t *testing.T) {
+	assert.Equal(t, Chord{'g', 'b'}, GetKey("g b"))
+	assert.Equal(t, Chord{gocui.KeySpace, 'c', 'f'}, GetKey("<space> c f"))
+	assert.Equal(t, Chord{gocui.KeyCtrlX, gocui.KeyCtrlB}, GetKey("<c-x>  <c-b>"))
+	assert.Equal(t, 'g', GetKey("g"))
+
+	assert.Equal(t, "<space> c f", LabelFromKey(GetKey("<space> c f")))
+	assert.Equal(t, "<space> c f", Label("<space> c f"))
+}
+
+func TestGroupChords(t *testing.T) {
+	binding := func(viewName string, key string, description string) *types.Binding {
+		return &types.Binding{ViewName: viewName, Key: GetKey(key), Description: description}
+	}
+
+	summarize := func(node *ChordNode) []string {
+		return lo.Map(node.Children, func(child *ChordNode, _ int) string {
+			if child.Binding != nil {
+				return LabelFromKey(child.Prefix) + ": " + child.Binding.Description
+			}
+			return LabelFromKey(child.Prefix) + ": ..."
+		})
+	}
+
+	scenarios := []struct {
+		testName         string
+		bindings         []*types.Binding
+		expectedBindings []string
+		expectedChords   map[string][]string
+		expectedErrors   []string
+	}{
+		{
+			testName: "no chords",
+			bindings: []*types.Binding{
+				binding("files", "c", "Commit"),
+				binding("", "q", "Quit"),
+			},
+			expectedBindings: []string{"files c", "global q"},
+			expectedChords:   map[string][]string{},
+		},
+		{
+			testName: "chords are grouped by view and first key, in place of the first chord",
+			bindings: []*types.Binding{
+				binding("files", "c", "Commit"),
+				binding("files", "g a", "Amend"),
+				binding("files", "x", "Menu"),
+				binding("files", "g b", "Branches"),
+				binding("commits", "g a", "Amend commit"),
+				binding("files", "<space> c f", "Fixup"),
+				binding("files", "<space> c s", "Squash"),
+				binding("files", "<space> p", "Push"),
+			},
+			expectedBindings: []string{"files c", "files g", "files x", "commits g", "files <space>"},
+			expectedChords: map[string][]string{
+				"files g":       {"g a: Amend", "g b: Branches"},
+				"commits g":     {"g a: Amend commit"},
+				"files <space>": {"<space> c: ...", "<space> p: Push"},
+			},
+		},
+		{
+			testName: "global chords are offered in views with chords starting with the same key",
+			bindings: []*types.Binding{
+				binding("files", "g a", "Amend"),
+				binding("", "g a", "Global amend"),
+				binding("", "g s", "Status"),
+			},
+			expectedBindings: []string{"files g", "global g"},
+			expectedChords: map[string][]string{
+				"files g":  {"g a: Amend", "g s: Status"},
+				"global g": {"g a: Global amend", "g s: Status"},
+			},
+		},
+		{
+			testName: "the first of two identical chords wins",
+			bindings: []*types.Binding{
+				binding("files", "g a", "First"),
+				binding("files", "g a", "Second"),
+			},
+			expectedBindings: []string{"files g"},
+			expectedChords: map[string][]string{
+				"files g": {"g a: First"},
+			},
+		},
+		{
+			testName: "a single-key binding in another view doesn't conflict",
+			bindings: []*types.Binding{
+				binding("commits", "g", "Reset"),
+				binding("files", "g a", "Amend"),
+			},
+			expectedBindings: []string{"commits g", "files g"},
+			expectedChords: map[string][]string{
+				"files g": {"g a: Amend"},
+			},
+		},
+		{
+			testName: "conflict with single-key binding in the same view",
+			bindings: []*types.Binding{
+				binding("files", "g a", "Amend"),
+				binding("files", "g", "Reset"),
+			},
+			expectedBindings: []string{"files g"},
+			expectedChords:   map[string][]string{},
+			expectedErrors:   []string{"Keybinding 'g a' (Amend) conflicts with keybinding 'g' (Reset) in the same view"},
+		},
+		{
+			testName: "conflict with a shorter chord",
+			bindings: []*types.Binding{
+				binding("files", "g a", "Amend"),
+				binding("files", "g a b", "Amend branch"),
+			},
+			expectedBindings: []string{"files g"},
+			expectedChords: map[string][]string{
+				"files g": {"g a: Amend"},
+			},
+			expectedErrors: []string{"Keybinding 'g a b' (Amend branch) conflicts with keybinding 'g a' (Amend) in the same view"},
+		},
+		{
+			testName: "conflict with a longer chord",
+			bindings: []*types.Binding{
+				binding("files", "g a b", "Amend branch"),
+				binding("files", "g a", "Amend"),
+			},
+			expectedBindings: []string{"files g"},
+			expectedChords: map[string][]string{
+				"files g": {"g a: ..."},
+			},
+			expectedErrors: []string{"Keybinding 'g a' (Amend) conflicts with keybinding 'g a b' (Amend branch) in the same view"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			bindingName := func(binding *types.Binding) string {
+				viewName := binding.ViewName
+				if viewName == "" {
+					viewName = "global"
+				}
+				return viewName + " " + LabelFromKey(binding.Key)
+			}
+
+			var openedNode *ChordNode
+			result, errs := GroupChords(s.bindings, func(node *ChordNode) error {
+				openedNode = node
+				return nil
+			})
+			assert.Equal(t, len(s.expectedErrors), len(errs))
+			for i, expectedError := range s.expectedErrors {
+				assert.ErrorContains(t, errs[i], expectedError)
+			}
+
+			assert.Equal(t, s.expectedBindings, lo.Map(result, func(binding *types.Binding, _ int) string {
+				return bindingName(binding)
+			}))
+
+			chords := map[string][]string{}
+			for _, binding := range result {
+				if binding.Handler != nil {
+					assert.NoError(t, binding.Handler())
+					chords[bindingName(binding)] = summarize(openedNode)
+				}
+			}
+
+			assert.Equal(t, s.expectedChords, chords)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package keybindings
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// A key that has more than one binding in a view, of which only the first
+// takes effect
+type Conflict struct {
+	// empty for global keybindings
+	ViewName string
+	Key      types.Key
+	// the binding that is invoked when pressing the key in the view
+	Effective *types.Binding
+	// the bindings that are never invoked in the view. For a view-specific
+	// binding, these can be global bindings for the same key.
+	Shadowed []*types.Binding
+}
+
+// Finds the conflicts in bindings, except for the ones where the default config
+// deliberately overrides a global key in a specific view (e.g. 'R' renames a
+// branch in the branches view, but refreshes everywhere else). Clashes between
+// two bindings of the same view are always reported, even if they come from
+// the defaults, because one of them can never be invoked.
+func FindConflicts(bindings []*types.Binding, defaultBindings []*types.Binding) []*Conflict {
+	defaultOverrides := lo.FilterMap(findConflicts(defaultBindings), func(conflict *Conflict, _ int) (string, bool) {
+		return conflict.signature(), conflict.overridesGlobal()
+	})
+	defaultConflicts := lo.SliceToMap(defaultOverrides, func(signature string) (string, bool) {
+		return signature, true
+	})
+
+	return lo.Filter(findConflicts(bindings), func(conflict *Conflict, _ int) bool {
+		return !defaultConflicts[conflict.signature()]
+	})
+}
+
+// Finds keys that are bound more than once in the same view, or bound both
+// globally and in a view, mirroring how gocui dispatches a key press: the first
+// binding for the focused view wins, then the first global one. Bindings
+// without a description are ignored since they aren't configurable actions
+// (e.g. alternative navigation keys), as are repeated bindings for the same
+// action.
+func findConflicts(bindings []*types.Binding) []*Conflict {
+	type viewAndKey struct {
+		viewName string
+		key      string
+	}
+
+	relevantBindings := lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+		return binding.Key != nil && binding.Description != ""
+	})
+
+	conflictsByViewAndKey := map[viewAndKey]*Conflict{}
+	conflicts := []*Conflict{}
+	for _, binding := range relevantBindings {
+		viewAndKey := viewAndKey{viewName: binding.ViewName, key: LabelFromKey(binding.Key)}
+		conflict, ok := conflictsByViewAndKey[viewAndKey]
+		if !ok {
+			conflict = &Conflict{ViewName: binding.ViewName, Key: binding.Key, Effective: binding}
+			conflictsByViewAndKey[viewAndKey] = conflict
+			conflicts = append(conflicts, conflict)
+			continue
+		}
+
+		conflict.addShadowed(binding)
+	}
+
+	for _, conflict := range conflicts {
+		if conflict.ViewName == "" {
+			continue
+		}
+
+		global, ok := conflictsByViewAndKey[viewAndKey{viewName: "", key: LabelFromKey(conflict.Key)}]
+		if ok {
+			conflict.addShadowed(global.Effective)
+		}
+	}
+
+	return lo.Filter(conflicts, func(conflict *Conflict, _ int) bool {
+		return len(conflict.Shadowed) > 0
+	})
+}
+
+func (self *Conflict) addShadowed(binding *types.Binding) {
+	if binding.Description == self.Effective.Description {
+		return
+	}
+	if lo.ContainsBy(self.Shadowed, func(shadowed *types.Binding) bool {
+		return shadowed.Description == binding.Description
+	}) {
+		return
+	}
+
+	self.Shadowed = append(self.Shadowed, binding)
+}
+
+// Whether the conflict is only about a view's binding taking precedence over
+// global ones
+func (self *Conflict) overridesGlobal() bool {
+	return self.ViewName != "" && lo.EveryBy(self.Shadowed, func(binding *types.Binding) bool {
+		return binding.ViewName == ""
+	})
+}
+
+func (self *Conflict) ShadowedDescriptions() []string {
+	return lo.Map(self.Shadowed, func(binding *types.Binding, _ int) string {
+		return binding.Description
+	})
+}
+
+func (self *Conflict) signature() string {
+	return strings.Join(
+		append([]string{self.ViewName, LabelFromKey(self.Key), self.Effective.Description}, self.ShadowedDescriptions()...),
+		"\x00",
+	)
+}
@@ -0,0 +1,125 @@
+package keybindings
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConflicts(t *testing.T) {
+	binding := func(viewName string, key string, description string) *types.Binding {
+		return &types.Binding{ViewName: viewName, Key: GetKey(key), Description: description}
+	}
+
+	defaultBindings := []*types.Binding{
+		binding("", "R", "Refresh"),
+		binding("", "P", "Push"),
+		binding("localBranches", "R", "Rename branch"),
+		binding("files", "c", "Commit"),
+		binding("files", "C", "Commit with editor"),
+	}
+
+	type conflictSummary struct {
+		viewName  string
+		key       string
+		effective string
+		shadowed  []string
+	}
+
+	scenarios := []struct {
+		testName string
+		bindings []*types.Binding
+		// defaults to defaultBindings
+		defaults []*types.Binding
+		expected []conflictSummary
+	}{
+		{
+			testName: "overrides of global keys in the default config aren't reported",
+			bindings: defaultBindings,
+			expected: []conflictSummary{},
+		},
+		{
+			testName: "view binding shadowing a global one",
+			bindings: []*types.Binding{
+				binding("", "R", "Refresh"),
+				binding("", "P", "Push"),
+				binding("localBranches", "R", "Rename branch"),
+				binding("files", "P", "Commit"),
+				binding("files", "C", "Commit with editor"),
+			},
+			expected: []conflictSummary{
+				{viewName: "files", key: "P", effective: "Commit", shadowed: []string{"Push"}},
+			},
+		},
+		{
+			testName: "duplicate in the same view, including a custom command",
+			bindings: []*types.Binding{
+				binding("files", "c", "My custom command"),
+				binding("", "R", "Refresh"),
+				binding("", "P", "Push"),
+				binding("localBranches", "R", "Rename branch"),
+				binding("files", "c", "Commit"),
+				binding("files", "C", "Commit with editor"),
+			},
+			expected: []conflictSummary{
+				{viewName: "files", key: "c", effective: "My custom command", shadowed: []string{"Commit"}},
+			},
+		},
+		{
+			testName: "a default conflict that gets another binding is reported",
+			bindings: []*types.Binding{
+				binding("", "R", "Refresh"),
+				binding("", "P", "Push"),
+				binding("localBranches", "R", "Rename branch"),
+				binding("localBranches", "R", "Rebase"),
+				binding("files", "c", "Commit"),
+				binding("files", "C", "Commit with editor"),
+			},
+			expected: []conflictSummary{
+				{viewName: "localBranches", key: "R", effective: "Rename branch", shadowed: []string{"Rebase", "Refresh"}},
+			},
+		},
+		{
+			testName: "a clash within a view of the default config is reported",
+			bindings: append(defaultBindings, binding("files", "c", "Amend")),
+			defaults: append(defaultBindings, binding("files", "c", "Amend")),
+			expected: []conflictSummary{
+				{viewName: "files", key: "c", effective: "Commit", shadowed: []string{"Amend"}},
+			},
+		},
+		{
+			testName: "bindings for the same action and bindings without description are ignored",
+			bindings: []*types.Binding{
+				binding("", "R", "Refresh"),
+				binding("", "P", "Push"),
+				binding("localBranches", "R", "Rename branch"),
+				binding("files", "c", "Commit"),
+				binding("files", "c", "Commit"),
+				binding("files", "c", ""),
+				binding("files", "C", "Commit with editor"),
+			},
+			expected: []conflictSummary{},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			defaults := s.defaults
+			if defaults == nil {
+				defaults = defaultBindings
+			}
+			actual := lo.Map(FindConflicts(s.bindings, defaults), func(conflict *Conflict, _ int) conflictSummary {
+				return conflictSummary{
+					viewName:  conflict.ViewName,
+					key:       LabelFromKey(conflict.Key),
+					effective: conflict.Effective.Description,
+					shadowed:  conflict.ShadowedDescriptions(),
+				}
+			})
+			assert.Equal(t, s.expected, actual)
+		})
+	}
+}
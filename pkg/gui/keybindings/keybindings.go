@@ -85,6 +85,8 @@ func LabelFromKey(key types.Key) string {
 	keyInt := 0
 
 	switch key := key.(type) {
+	case Chord:
+		return strings.Join(lo.Map(key, func(key types.Key, _ int) string { return LabelFromKey(key) }), " ")
 	case rune:
 		keyInt = int(key)
 	case gocui.Key:
@@ -98,10 +100,26 @@ func LabelFromKey(key types.Key) string {
 	return fmt.Sprintf("%c", keyInt)
 }
 
+// Returns an error for keys that GetKey doesn't recognize, for keybindings
+// that don't come from the user config (where invalid keys are fatal)
+func ValidateKey(key string) error {
+	for _, key := range strings.Fields(key) {
+		if key == "<disabled>" || utf8.RuneCountInString(key) <= 1 {
+			continue
+		}
+		if _, ok := keyByLabel[strings.ToLower(key)]; !ok {
+			return fmt.Errorf("unrecognized key %s. For permitted values see %s", strings.ToLower(key), constants.Links.Docs.CustomKeybindings)
+		}
+	}
+	return nil
+}
+
 func GetKey(key string) types.Key {
 	runeCount := utf8.RuneCountInString(key)
 	if key == "<disabled>" {
 		return nil
+	} else if keys := strings.Fields(key); len(keys) > 1 {
+		return getChord(keys)
 	} else if runeCount > 1 {
 		binding, ok := keyByLabel[strings.ToLower(key)]
 		if !ok {
@@ -0,0 +1,35 @@
+package keybindings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateKey(t *testing.T) {
+	scenarios := []struct {
+		key         string
+		expectedErr bool
+	}{
+		{key: "a"},
+		{key: "<c-a>"},
+		{key: "<F5>"},
+		{key: "<disabled>"},
+		{key: "g <c-b>"},
+		{key: ""},
+		{key: "<c-nope>", expectedErr: true},
+		{key: "g foo", expectedErr: true},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.key, func(t *testing.T) {
+			err := ValidateKey(s.key)
+			if s.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
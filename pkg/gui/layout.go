@@ -154,11 +154,21 @@ func (gui *Gui) layout(g *gocui.Gui) error {
 
 	mainViewWidth, mainViewHeight := gui.Views.Main.Size()
 	if mainViewWidth != gui.PrevLayout.MainWidth || mainViewHeight != gui.PrevLayout.MainHeight {
+		widthChanged := gui.PrevLayout.MainWidth != 0 && mainViewWidth != gui.PrevLayout.MainWidth
 		gui.PrevLayout.MainWidth = mainViewWidth
 		gui.PrevLayout.MainHeight = mainViewHeight
 		if err := gui.onResize(); err != nil {
 			return err
 		}
+
+		// side-by-side diffs are laid out for the width of the view. The staging
+		// and patch building views don't show them, so they're left alone.
+		currentContext := gui.c.CurrentStaticContext()
+		if widthChanged && gui.helpers.Diff.ShowingSideBySide() && currentContext.GetKind() == types.SIDE_CONTEXT {
+			if err := currentContext.HandleRenderToMain(); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, context := range contextsToRerender {
@@ -272,6 +282,8 @@ func (gui *Gui) onInitialViewsCreation() error {
 		gui.showRecentRepos = false
 	}
 
+	gui.reportKeybindingConflicts()
+
 	gui.helpers.Update.CheckForUpdateInBackground()
 
 	gui.waitForIntro.Done()
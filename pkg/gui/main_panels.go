@@ -2,6 +2,7 @@ package gui
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
@@ -19,7 +20,16 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 	case *types.RunCommandTask:
 		return gui.newCmdTask(view, v.Cmd, v.Prefix)
 
+	case *types.DeferredTask:
+		return gui.newDeferredTask(view, v.GetTask)
+
 	case *types.RunPtyTask:
+		if v.IsDiff && gui.helpers.Diff.ShowingSideBySide() {
+			return gui.newSideBySideDiffTask(view, v.Cmd, v.Prefix)
+		}
+		if wordDiff := gui.helpers.Diff.WordDiffMode(); v.IsDiff && wordDiff != patch.WordDiffOff {
+			return gui.newWordDiffTask(view, v.Cmd, v.Prefix, wordDiff)
+		}
 		return gui.newPtyTask(view, v.Cmd, v.Prefix)
 	}
 
@@ -6,8 +6,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
+// Shown in accessible mode in front of the lines of the selected hunk
+const SELECTED_HUNK_SYMBOL = "▶"
+
 func ColoredConflictFile(state *State, hasFocus bool) string {
 	content := state.GetContent()
 	if len(state.conflicts) == 0 {
@@ -21,12 +25,17 @@ func ColoredConflictFile(state *State, hasFocus bool) string {
 			textStyle = style.FgRed
 		}
 
-		if hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection()) {
+		highlighted := hasFocus && state.conflictIndex < len(state.conflicts) && *state.conflicts[state.conflictIndex] == *conflict && shouldHighlightLine(i, conflict, state.Selection())
+		if highlighted {
 			textStyle = textStyle.MergeStyle(theme.SelectedRangeBgColor).SetBold()
 		}
 		if i == conflict.end && len(remainingConflicts) > 0 {
 			conflict, remainingConflicts = shiftConflict(remainingConflicts)
 		}
+		if theme.AccessibleMode {
+			// the selected hunk is otherwise only shown by its background
+			outputBuffer.WriteString(lo.Ternary(highlighted, SELECTED_HUNK_SYMBOL+" ", "  "))
+		}
 		outputBuffer.WriteString(textStyle.Sprint(line) + "\n")
 	}
 	return outputBuffer.String()
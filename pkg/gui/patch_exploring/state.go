@@ -195,7 +195,7 @@ func (s *State) AdjustSelectedLineIdx(change int) {
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int) string {
+func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int, wordDiff patch.WordDiffMode) string {
 	firstLineIdx, lastLineIdx := s.SelectedRange()
 	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
 	return s.patch.FormatView(patch.FormatViewOpts{
@@ -203,6 +203,7 @@ func (s *State) RenderForLineIndices(isFocused bool, includedLineIndices []int)
 		FirstLineIndex: firstLineIdx,
 		LastLineIndex:  lastLineIdx,
 		IncLineIndices: includedLineIndicesSet,
+		WordDiff:       wordDiff,
 	})
 }
 
@@ -4,10 +4,13 @@ import (
 	"context"
 	"strings"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 )
 
@@ -22,6 +25,7 @@ type PopupHandler struct {
 	createMenuFn        func(types.CreateMenuOptions) error
 	withWaitingStatusFn func(message string, f func(gocui.Task) error)
 	toastFn             func(message string)
+	logCommandFn        func(cmdStr string, isCommandLine bool)
 	getPromptInputFn    func() string
 	inDemo              func() bool
 }
@@ -37,6 +41,7 @@ func NewPopupHandler(
 	createMenuFn func(types.CreateMenuOptions) error,
 	withWaitingStatusFn func(message string, f func(gocui.Task) error),
 	toastFn func(message string),
+	logCommandFn func(cmdStr string, isCommandLine bool),
 	getPromptInputFn func() string,
 	inDemo func() bool,
 ) *PopupHandler {
@@ -50,6 +55,7 @@ func NewPopupHandler(
 		createMenuFn:        createMenuFn,
 		withWaitingStatusFn: withWaitingStatusFn,
 		toastFn:             toastFn,
+		logCommandFn:        logCommandFn,
 		getPromptInputFn:    getPromptInputFn,
 		inDemo:              inDemo,
 	}
@@ -73,6 +79,17 @@ func (self *PopupHandler) Error(err error) error {
 		return err
 	}
 
+	// The user knows that they cancelled the command, so there's no need for a
+	// popup. The command may have changed things before it was terminated,
+	// though.
+	var cancelledErr *oscommands.CancelledError
+	if errors.As(err, &cancelledErr) {
+		self.logCommandFn(utils.ResolvePlaceholderString(self.Tr.CommandCancelled, map[string]string{
+			"command": cancelledErr.Command,
+		}), false)
+		return self.onErrorFn()
+	}
+
 	return self.ErrorMsg(err.Error())
 }
 
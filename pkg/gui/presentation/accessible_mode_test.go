@@ -0,0 +1,156 @@
+package presentation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Colors are disabled in these tests, so the snapshots show what's left for
+// someone who can't tell the colors apart
+func TestAccessibleModeSnapshots(t *testing.T) {
+	theme.AccessibleMode = true
+	defer func() { theme.AccessibleMode = false }()
+
+	t.Run("files", func(t *testing.T) {
+		files := []*models.File{
+			{Name: "dir1/staged", ShortStatus: "M ", HasStagedChanges: true},
+			{Name: "dir1/unstaged", ShortStatus: " M", HasUnstagedChanges: true},
+			{Name: "dir2/both", ShortStatus: "MM", HasStagedChanges: true, HasUnstagedChanges: true},
+			{Name: "dir2/untracked", ShortStatus: "??", HasUnstagedChanges: true},
+			{Name: "added", ShortStatus: "A ", HasStagedChanges: true},
+			{Name: "deleted", ShortStatus: " D", HasUnstagedChanges: true},
+		}
+		viewModel := filetree.NewFileTree(func() []*models.File { return files }, utils.NewDummyLog(), true)
+		viewModel.SetTree()
+
+		assertSnapshot(t, "accessible_files", RenderFileTree(viewModel, "", nil))
+	})
+
+	t.Run("commit files while building a patch", func(t *testing.T) {
+		files := []*models.CommitFile{
+			{Name: "dir/whole", ChangeStatus: "M"},
+			{Name: "dir/part", ChangeStatus: "M"},
+			{Name: "other/unselected", ChangeStatus: "A"},
+			{Name: "deleted", ChangeStatus: "D"},
+		}
+		viewModel := filetree.NewCommitFileTreeViewModel(func() []*models.CommitFile { return files }, utils.NewDummyLog(), true)
+		viewModel.SetRef(&models.Commit{Sha: "to"})
+		viewModel.SetTree()
+
+		patchBuilder := patch.NewPatchBuilder(
+			utils.NewDummyLog(),
+			func(from string, to string, reverse bool, filename string, plain bool) (string, error) {
+				return "", nil
+			},
+		)
+		patchBuilder.Start("from", "to", false, false)
+		assert.NoError(t, patchBuilder.AddFileWhole("dir/whole"))
+		assert.NoError(t, patchBuilder.AddFileWhole("deleted"))
+		assert.NoError(t, patchBuilder.AddFileLineRange("dir/part", 1, 2))
+
+		assertSnapshot(t, "accessible_commit_files", RenderCommitFileTree(viewModel, "", patchBuilder))
+	})
+
+	t.Run("commits", func(t *testing.T) {
+		commits := []*models.Commit{
+			{Name: "unpushed", Sha: "sha1", Status: models.StatusUnpushed},
+			{Name: "pushed", Sha: "sha2", Status: models.StatusPushed},
+			{Name: "merged", Sha: "sha3", Status: models.StatusMerged},
+			{Name: "rebasing", Sha: "sha4", Status: models.StatusRebasing, Action: models.ActionNone},
+		}
+
+		result := GetCommitListDisplayStrings(
+			utils.NewDummyCommon(),
+			commits,
+			nil,
+			"",
+			false,
+			false,
+			set.New[string](),
+			"",
+			"",
+			"",
+			"",
+			time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			false,
+			"",
+			0,
+			len(commits),
+			false,
+			git_commands.NewNullBisectInfo(),
+			false,
+		)
+		lines, _ := utils.RenderDisplayStrings(result, nil)
+
+		assertSnapshot(t, "accessible_commits", lines)
+	})
+
+	t.Run("branches", func(t *testing.T) {
+		branches := []*models.Branch{
+			{Name: "up-to-date", UpstreamRemote: "origin", Pushables: "0", Pullables: "0"},
+			{Name: "ahead", UpstreamRemote: "origin", Pushables: "2", Pullables: "0"},
+			{Name: "behind", UpstreamRemote: "origin", Pushables: "0", Pullables: "3"},
+			{Name: "diverged", UpstreamRemote: "origin", Pushables: "1", Pullables: "1"},
+			{Name: "gone", UpstreamRemote: "origin", UpstreamGone: true},
+			{Name: "local"},
+		}
+		tr := i18n.EnglishTranslationSet()
+
+		result := GetBranchListDisplayStrings(
+			branches,
+			func(types.HasUrn) types.ItemOperation { return types.ItemOperationNone },
+			false,
+			"",
+			100,
+			&tr,
+			config.GetDefaultConfig(),
+			nil,
+		)
+		lines, _ := utils.RenderDisplayStrings(result, nil)
+
+		assertSnapshot(t, "accessible_branches", lines)
+	})
+
+	t.Run("merge conflicts", func(t *testing.T) {
+		state := mergeconflicts.NewState()
+		state.SetContent("before\n<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\nafter\n", "file")
+
+		assertSnapshot(t, "accessible_merge_conflicts", utils.SplitLines(mergeconflicts.ColoredConflictFile(state, true)))
+	})
+}
+
+// Compares the lines with the snapshot of the given name in testdata/snapshots.
+// Run the tests with UPDATE_SNAPSHOTS=true to write the snapshots instead.
+func assertSnapshot(t *testing.T, name string, lines []string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "snapshots", name+".snap")
+	actual := strings.Join(lines, "\n") + "\n"
+
+	if os.Getenv("UPDATE_SNAPSHOTS") == "true" {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(actual), 0o644))
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if assert.NoError(t, err, "missing snapshot, run the tests with UPDATE_SNAPSHOTS=true to create it") {
+		assert.Equal(t, string(expected), actual)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/lucasb-eyer/go-colorful"
 	"github.com/mattn/go-runewidth"
@@ -61,12 +62,29 @@ func AuthorStyle(authorName string) style.TextStyle {
 	}
 
 	value := trueColorStyle(authorName)
+	if len(theme.AuthorColorPalette) > 0 {
+		value = paletteStyle(authorName, theme.AuthorColorPalette)
+	}
 
 	authorStyleCache[authorName] = value
 
 	return value
 }
 
+// The style for the commit graph of the author's commits
+func GraphStyle(authorName string) style.TextStyle {
+	if len(theme.GraphColorPalette) > 0 {
+		return paletteStyle(authorName, theme.GraphColorPalette)
+	}
+
+	return AuthorStyle(authorName)
+}
+
+func paletteStyle(str string, palette []style.TextStyle) style.TextStyle {
+	hash := md5.Sum([]byte(str))
+	return palette[randInt(hash[:], len(palette))]
+}
+
 func trueColorStyle(str string) style.TextStyle {
 	hash := md5.Sum([]byte(str))
 	c := colorful.Hsl(randFloat(hash[0:4])*360.0, 0.6+0.4*randFloat(hash[4:8]), 0.4+randFloat(hash[8:12])*0.2)
@@ -113,6 +131,10 @@ func getFirstRune(str string) rune {
 	return 0
 }
 
+// Sets the colors of specific authors, and clears the colors that were picked
+// for the others, e.g. because the theme changed
 func SetCustomAuthors(customAuthorColors map[string]string) {
+	authorInitialCache = make(map[string]string)
+	authorNameCache = make(map[string]string)
 	authorStyleCache = utils.SetCustomColors(customAuthorColors)
 }
@@ -31,6 +31,15 @@ var (
 	mutex        deadlock.Mutex
 )
 
+// Clears the cached commit graphs, e.g. because the theme's graph colors
+// changed
+func ClearPipeSetCache() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	pipeSetCache = make(map[pipeSetCacheKey][][]*graph.Pipe)
+}
+
 type bisectBounds struct {
 	newIndex int
 	oldIndex int
@@ -211,7 +220,7 @@ func loadPipesets(commits []*models.Commit) [][]*graph.Pipe {
 		// pipe sets are unique to a commit head. and a commit count. Sometimes we haven't loaded everything for that.
 		// so let's just cache it based on that.
 		getStyle := func(commit *models.Commit) style.TextStyle {
-			return authors.AuthorStyle(commit.AuthorName)
+			return authors.GraphStyle(commit.AuthorName)
 		}
 		pipeSets = graph.GetPipeSets(commits, getStyle)
 		pipeSetCache[cacheKey] = pipeSets
@@ -358,13 +367,18 @@ func displayCommit(
 		authorFunc = authors.LongAuthor
 	}
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 8)
 	if commit.Divergence != models.DivergenceNone {
 		cols = append(cols, shaColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, "↑", "↓")))
 	} else if icons.IsIconEnabled() {
 		cols = append(cols, shaColor.Sprint(icons.IconForCommit(commit)))
 	}
-	cols = append(cols, shaColor.Sprint(commit.ShortSha()))
+	if theme.AccessibleMode {
+		cols = append(cols, shaColor.Sprint(commitStatusSymbol(commit)+" "+commit.ShortSha()))
+	} else {
+		cols = append(cols, shaColor.Sprint(commit.ShortSha()))
+	}
+	cols = append(cols, getSignatureStatusText(commit, fullDescription))
 	cols = append(cols, bisectString)
 	if fullDescription {
 		cols = append(cols, style.FgBlue.Sprint(
@@ -381,6 +395,33 @@ func displayCommit(
 	return cols
 }
 
+// Each status gets its own symbol as well as its own colour, so that they can be
+// told apart without relying on colour alone. The full description also shows
+// who signed the commit.
+func getSignatureStatusText(commit *models.Commit, fullDescription bool) string {
+	var textStyle style.TextStyle
+	var symbol string
+	switch commit.SignatureStatus {
+	case models.SignatureNotLoaded:
+		return ""
+	case models.SignatureGood:
+		textStyle, symbol = style.FgGreen, "✓"
+	case models.SignatureBad, models.SignatureRevokedKey:
+		textStyle, symbol = style.FgRed, "✗"
+	case models.SignatureNone:
+		textStyle, symbol = style.FgDefault, "-"
+	default:
+		// the signature is valid but can't be fully trusted, e.g. because the key
+		// is missing, unknown, or expired
+		textStyle, symbol = style.FgYellow, "?"
+	}
+
+	if fullDescription && commit.Signer != "" {
+		return textStyle.Sprint(symbol + " " + commit.Signer)
+	}
+	return textStyle.Sprint(symbol)
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
@@ -401,6 +442,21 @@ func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	return style.FgWhite
 }
 
+// Shown in accessible mode, where the status of a commit can't be told by the
+// color of its hash alone
+func commitStatusSymbol(commit *models.Commit) string {
+	switch commit.Status {
+	case models.StatusUnpushed:
+		return "↑"
+	case models.StatusPushed:
+		return "•"
+	case models.StatusMerged:
+		return "✓"
+	default:
+		return " "
+	}
+}
+
 func getShaColor(
 	commit *models.Commit,
 	diffName string,
@@ -93,6 +93,47 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		sha2 commit2
 						`),
 		},
+		{
+			testName: "commits with signature status",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", SignatureStatus: models.SignatureGood},
+				{Name: "commit2", Sha: "sha2", SignatureStatus: models.SignatureNone},
+				{Name: "commit3", Sha: "sha3", SignatureStatus: models.SignatureBad},
+				{Name: "commit4", Sha: "sha4", SignatureStatus: models.SignatureCannotCheck},
+			},
+			startIdx:                 0,
+			endIdx:                   4,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 ✓ commit1
+		sha2 - commit2
+		sha3 ✗ commit3
+		sha4 ? commit4
+						`),
+		},
+		{
+			testName: "commits with signer in the full description",
+			commits: []*models.Commit{
+				{Name: "commit1", Sha: "sha1", UnixTimestamp: 1577844184, AuthorName: "Jesse Duffield", SignatureStatus: models.SignatureGood, Signer: "Jesse Duffield <jessedduffield@gmail.com>"},
+				{Name: "commit2", Sha: "sha2", UnixTimestamp: 1577844184, AuthorName: "Jesse Duffield", SignatureStatus: models.SignatureNone},
+			},
+			fullDescription:          true,
+			timeFormat:               "2006-01-02",
+			shortTimeFormat:          "3:04PM",
+			startIdx:                 0,
+			endIdx:                   2,
+			showGraph:                false,
+			bisectInfo:               git_commands.NewNullBisectInfo(),
+			cherryPickedCommitShaSet: set.New[string](),
+			now:                      time.Date(2020, 1, 1, 5, 3, 4, 0, time.UTC),
+			expected: formatExpected(`
+		sha1 ✓ Jesse Duffield <jessedduffield@gmail.com> 2:03AM Jesse Duffield    commit1
+		sha2 -                                           2:03AM Jesse Duffield    commit2
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commits: []*models.Commit{
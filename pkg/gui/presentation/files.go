@@ -18,6 +18,15 @@ const (
 	COLLAPSED_ARROW = "▶"
 )
 
+// Shown in accessible mode, where staged and unstaged changes can't be told
+// apart by color alone. Also used for whether a commit file is in the custom
+// patch.
+const (
+	STAGED_SYMBOL           = "●"
+	PARTIALLY_STAGED_SYMBOL = "◐"
+	UNSTAGED_SYMBOL         = "○"
+)
+
 // keeping these here as individual constants in case later on people want the old tree shape
 const (
 	INNER_ITEM = "  "
@@ -43,6 +52,8 @@ func RenderCommitFileTree(
 	diffName string,
 	patchBuilder *patch.PatchBuilder,
 ) []string {
+	showPatchStatus := theme.AccessibleMode && patchBuilder.Active()
+
 	return renderAux(tree.GetRoot().Raw(), tree.CollapsedPaths(), "", -1, func(node *filetree.Node[models.CommitFile], depth int) string {
 		// This is a little convoluted because we're dealing with either a leaf or a non-leaf.
 		// But this code actually applies to both. If it's a leaf, the status will just
@@ -61,7 +72,7 @@ func RenderCommitFileTree(
 			status = patch.PART
 		}
 
-		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status)
+		return getCommitFileLine(commitFileNameAtDepth(node, depth), diffName, node.File, status, showPatchStatus)
 	})
 }
 
@@ -120,24 +131,24 @@ func renderAux[T any](
 }
 
 func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, diffName string, submoduleConfigs []*models.SubmoduleConfig, file *models.File) string {
-	// potentially inefficient to be instantiating these color
-	// objects with each render
-	partiallyModifiedColor := style.FgYellow
-
-	restColor := style.FgGreen
+	restColor := theme.StagedChangesColor
 	if name == diffName {
 		restColor = theme.DiffTerminalColor
 	} else if file == nil && hasStagedChanges && hasUnstagedChanges {
-		restColor = partiallyModifiedColor
+		restColor = theme.PartiallyStagedChangesColor
 	} else if hasUnstagedChanges {
 		restColor = theme.UnstagedChangesColor
 	}
 
 	output := ""
+	if theme.AccessibleMode {
+		output += restColor.Sprint(stagingSymbol(hasUnstagedChanges, hasStagedChanges) + " ")
+	}
+
 	if file != nil {
 		// this is just making things look nice when the background attribute is 'reverse'
 		firstChar := file.ShortStatus[0:1]
-		firstCharCl := style.FgGreen
+		firstCharCl := theme.StagedChangesColor
 		if firstChar == "?" {
 			firstCharCl = theme.UnstagedChangesColor
 		} else if firstChar == " " {
@@ -150,7 +161,7 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 			secondCharCl = restColor
 		}
 
-		output = firstCharCl.Sprint(firstChar)
+		output += firstCharCl.Sprint(firstChar)
 		output += secondCharCl.Sprint(secondChar)
 		output += restColor.Sprint(" ")
 	}
@@ -171,25 +182,46 @@ func getFileLine(hasUnstagedChanges bool, hasStagedChanges bool, name string, di
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil {
+		switch file.LfsStatus {
+		case models.LfsPointer:
+			output += theme.DefaultTextColor.Sprint(" (LFS pointer)")
+		case models.LfsSmudged, models.LfsAbsent:
+			output += theme.DefaultTextColor.Sprint(" (LFS)")
+		}
+	}
+
 	return output
 }
 
-func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus) string {
+func stagingSymbol(hasUnstagedChanges bool, hasStagedChanges bool) string {
+	if hasStagedChanges && hasUnstagedChanges {
+		return PARTIALLY_STAGED_SYMBOL
+	} else if hasStagedChanges {
+		return STAGED_SYMBOL
+	}
+	return UNSTAGED_SYMBOL
+}
+
+func getCommitFileLine(name string, diffName string, commitFile *models.CommitFile, status patch.PatchStatus, showPatchStatus bool) string {
 	var colour style.TextStyle
 	if diffName == name {
 		colour = theme.DiffTerminalColor
 	} else {
 		switch status {
 		case patch.WHOLE:
-			colour = style.FgGreen
+			colour = theme.StagedChangesColor
 		case patch.PART:
-			colour = style.FgYellow
+			colour = theme.PartiallyStagedChangesColor
 		case patch.UNSELECTED:
 			colour = theme.DefaultTextColor
 		}
 	}
 
 	output := ""
+	if showPatchStatus {
+		output += colour.Sprint(patchStatusSymbol(status) + " ")
+	}
 
 	name = utils.EscapeSpecialChars(name)
 	if commitFile != nil {
@@ -210,18 +242,29 @@ func getCommitFileLine(name string, diffName string, commitFile *models.CommitFi
 	return output
 }
 
+func patchStatusSymbol(status patch.PatchStatus) string {
+	switch status {
+	case patch.WHOLE:
+		return STAGED_SYMBOL
+	case patch.PART:
+		return PARTIALLY_STAGED_SYMBOL
+	default:
+		return UNSTAGED_SYMBOL
+	}
+}
+
 func getColorForChangeStatus(changeStatus string) style.TextStyle {
 	switch changeStatus {
 	case "A":
-		return style.FgGreen
+		return theme.AddedFileColor
 	case "M", "R":
-		return style.FgYellow
+		return theme.ModifiedFileColor
 	case "D":
-		return theme.UnstagedChangesColor
+		return theme.DeletedFileColor
 	case "C":
-		return style.FgCyan
+		return theme.CopiedFileColor
 	case "T":
-		return style.FgMagenta
+		return theme.TypeChangedFileColor
 	default:
 		return theme.DefaultTextColor
 	}
@@ -41,6 +41,15 @@ func TestRenderFileTree(t *testing.T) {
 			},
 			expected: []string{" M test"},
 		},
+		{
+			name: "LFS files",
+			files: []*models.File{
+				{Name: "cover.psd", ShortStatus: " M", HasUnstagedChanges: true, LfsStatus: models.LfsPointer},
+				{Name: "logo.psd", ShortStatus: " M", HasUnstagedChanges: true, LfsStatus: models.LfsSmudged},
+				{Name: "old.psd", ShortStatus: " D", HasUnstagedChanges: true, LfsStatus: models.LfsAbsent},
+			},
+			expected: []string{" M cover.psd (LFS pointer)", " M logo.psd (LFS)", " D old.psd (LFS)"},
+		},
 		{
 			name: "big example",
 			files: []*models.File{
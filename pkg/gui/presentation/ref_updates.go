@@ -0,0 +1,43 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// Returns the flag, the refs and the summary of a ref updated by a push or
+// fetch, e.g. ['*', 'feature → origin/feature', '[new branch]']
+func GetRefUpdateDisplayStrings(update *git_commands.RefUpdate) []string {
+	textStyle := refUpdateStyle(update)
+
+	refs := shortRefName(update.To)
+	if update.From != "" {
+		refs = shortRefName(update.From) + " → " + refs
+	}
+
+	return []string{
+		textStyle.Sprint(string(update.Flag)),
+		refs,
+		textStyle.Sprint(update.Summary),
+	}
+}
+
+func refUpdateStyle(update *git_commands.RefUpdate) style.TextStyle {
+	switch update.Flag {
+	case '!', '-':
+		return style.FgRed
+	case '+', 't':
+		return style.FgYellow
+	case '=':
+		return style.FgDefault
+	default:
+		return style.FgGreen
+	}
+}
+
+// e.g. 'refs/heads/master' -> 'master'
+func shortRefName(ref string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+}
@@ -24,6 +24,8 @@ func NewClient(
 		sessionStateLoader,
 		helpers.Suggestions,
 		helpers.MergeAndRebase,
+		helpers.Commits,
+		helpers.Checklist,
 	)
 	keybindingCreator := NewKeybindingCreator(c)
 	customCommands := c.UserConfig.CustomCommands
@@ -1,13 +1,16 @@
 package custom_commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"text/template"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -22,6 +25,8 @@ type HandlerCreator struct {
 	menuGenerator        *MenuGenerator
 	suggestionsHelper    *helpers.SuggestionsHelper
 	mergeAndRebaseHelper *helpers.MergeAndRebaseHelper
+	commitsHelper        *helpers.CommitsHelper
+	checklistHelper      *helpers.ChecklistHelper
 }
 
 func NewHandlerCreator(
@@ -29,6 +34,8 @@ func NewHandlerCreator(
 	sessionStateLoader *SessionStateLoader,
 	suggestionsHelper *helpers.SuggestionsHelper,
 	mergeAndRebaseHelper *helpers.MergeAndRebaseHelper,
+	commitsHelper *helpers.CommitsHelper,
+	checklistHelper *helpers.ChecklistHelper,
 ) *HandlerCreator {
 	resolver := NewResolver(c.Common)
 	menuGenerator := NewMenuGenerator(c.Common)
@@ -40,16 +47,31 @@ func NewHandlerCreator(
 		menuGenerator:        menuGenerator,
 		suggestionsHelper:    suggestionsHelper,
 		mergeAndRebaseHelper: mergeAndRebaseHelper,
+		commitsHelper:        commitsHelper,
+		checklistHelper:      checklistHelper,
 	}
 }
 
 func (self *HandlerCreator) call(customCommand config.CustomCommand) func() error {
 	return func() error {
-		sessionState := self.sessionStateLoader.call()
+		if len(customCommand.Prompts) > 0 && len(customCommand.Steps) > 0 {
+			return self.c.ErrorMsg("custom command cannot have both prompts and steps")
+		}
+
+		sessionState := self.sessionStateLoader.Call()
 		promptResponses := make([]string, len(customCommand.Prompts))
 		form := make(map[string]string)
+		formLists := make(map[string][]string)
+		resolveTemplate := self.getResolveTemplateFn(form, formLists, promptResponses, sessionState)
+
+		f := func() error {
+			return self.finalHandler(customCommand, sessionState, promptResponses, form, formLists)
+		}
 
-		f := func() error { return self.finalHandler(customCommand, sessionState, promptResponses, form) }
+		if len(customCommand.Steps) > 0 {
+			g := f
+			f = func() error { return self.runSteps(customCommand.Steps, form, formLists, resolveTemplate, g) }
+		}
 
 		// if we have prompts we'll recursively wrap our confirm handlers with more prompts
 		// until we reach the actual command
@@ -67,43 +89,10 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 				return g()
 			}
 
-			resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState)
-
-			switch prompt.Type {
-			case "input":
-				f = func() error {
-					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
-					if err != nil {
-						return self.c.Error(err)
-					}
-					return self.inputPrompt(resolvedPrompt, wrappedF)
-				}
-			case "menu":
-				f = func() error {
-					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
-					if err != nil {
-						return self.c.Error(err)
-					}
-					return self.menuPrompt(resolvedPrompt, wrappedF)
-				}
-			case "menuFromCommand":
-				f = func() error {
-					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
-					if err != nil {
-						return self.c.Error(err)
-					}
-					return self.menuPromptFromCommand(resolvedPrompt, wrappedF)
-				}
-			case "confirm":
-				f = func() error {
-					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
-					if err != nil {
-						return self.c.Error(err)
-					}
-					return self.confirmPrompt(resolvedPrompt, g)
-				}
-			default:
-				return self.c.ErrorMsg("custom command prompt must have a type of 'input', 'menu', 'menuFromCommand', or 'confirm'")
+			var ok bool
+			f, ok = self.getPromptFn(prompt, formLists, resolveTemplate, wrappedF, g)
+			if !ok {
+				return self.c.ErrorMsg(invalidPromptTypeMessage)
 			}
 		}
 
@@ -111,6 +100,174 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 	}
 }
 
+const invalidPromptTypeMessage = "custom command prompt must have a type of 'input', 'menu', 'menuFromCommand', 'confirm', 'checklist', 'filePicker' or 'multilineInput'"
+
+// returns a function that shows the given prompt. onResponse is called with the
+// user's response; confirm prompts have no response and call onConfirm instead.
+// Checklist prompts also store the list of checked values in formLists.
+func (self *HandlerCreator) getPromptFn(
+	prompt config.CustomCommandPrompt,
+	formLists map[string][]string,
+	resolveTemplate func(string) (string, error),
+	onResponse func(string) error,
+	onConfirm func() error,
+) (func() error, bool) {
+	var show func(*config.CustomCommandPrompt) error
+	switch prompt.Type {
+	case "input":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.inputPrompt(resolvedPrompt, onResponse)
+		}
+	case "menu":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.menuPrompt(resolvedPrompt, onResponse)
+		}
+	case "menuFromCommand":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.menuPromptFromCommand(resolvedPrompt, onResponse)
+		}
+	case "confirm":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.confirmPrompt(resolvedPrompt, onConfirm)
+		}
+	case "checklist":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.checklistPrompt(resolvedPrompt, func(values []string) error {
+				formLists[prompt.Key] = values
+				return onResponse(strings.Join(values, " "))
+			})
+		}
+	case "filePicker":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.filePickerPrompt(resolvedPrompt, onResponse)
+		}
+	case "multilineInput":
+		show = func(resolvedPrompt *config.CustomCommandPrompt) error {
+			return self.multilineInputPrompt(resolvedPrompt, onResponse)
+		}
+	default:
+		return nil, false
+	}
+
+	return func() error {
+		resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
+		if err != nil {
+			return self.c.Error(err)
+		}
+		return show(resolvedPrompt)
+	}, true
+}
+
+// runs the given steps in order, then calls onDone. Steps whose condition is
+// false are skipped; a failing step aborts the chain.
+func (self *HandlerCreator) runSteps(
+	steps []config.CustomCommandStep,
+	form map[string]string,
+	formLists map[string][]string,
+	resolveTemplate func(string) (string, error),
+	onDone func() error,
+) error {
+	var runStep func(idx int) error
+	runStep = func(idx int) error {
+		if idx == len(steps) {
+			return onDone()
+		}
+
+		step := steps[idx]
+		next := func() error { return runStep(idx + 1) }
+
+		ok, err := evaluateCondition(step.If, resolveTemplate)
+		if err != nil {
+			return self.c.Error(err)
+		}
+		if !ok {
+			// so that later templates can still refer to the skipped step's value
+			if step.Prompt != nil {
+				form[step.Prompt.Key] = ""
+			} else if step.Key != "" {
+				form[step.Key] = ""
+			}
+			return next()
+		}
+
+		if step.Prompt != nil && step.Command != "" {
+			return self.c.ErrorMsg("custom command step cannot have both a prompt and a command")
+		}
+
+		if step.Prompt != nil {
+			showPrompt, ok := self.getPromptFn(*step.Prompt, formLists, resolveTemplate, func(response string) error {
+				form[step.Prompt.Key] = response
+				return next()
+			}, next)
+			if !ok {
+				return self.c.ErrorMsg(invalidPromptTypeMessage)
+			}
+			return showPrompt()
+		}
+
+		if step.Command != "" {
+			return self.runStepCommand(step, form, resolveTemplate, next)
+		}
+
+		return self.c.ErrorMsg("custom command step must have either a prompt or a command")
+	}
+
+	return runStep(0)
+}
+
+func (self *HandlerCreator) runStepCommand(
+	step config.CustomCommandStep,
+	form map[string]string,
+	resolveTemplate func(string) (string, error),
+	next func() error,
+) error {
+	cmdStr, err := resolveTemplate(step.Command)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	loadingText := step.LoadingText
+	if loadingText == "" {
+		loadingText = self.c.Tr.RunningCustomCommandStatus
+	}
+
+	return self.c.WithWaitingStatus(loadingText, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CustomCommand)
+
+		output, err := self.c.OS().Cmd.NewShell(cmdStr).RunWithOutput()
+
+		if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); refreshErr != nil {
+			self.c.Log.Error(refreshErr)
+		}
+
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		if step.Key != "" {
+			form[step.Key] = strings.TrimSpace(output)
+		}
+
+		self.c.OnUIThread(next)
+		return nil
+	})
+}
+
+// evaluates a step's `if` expression, which is a Go template pipeline such as
+// `eq .Form.Kind "feature"`. An empty condition is always true.
+func evaluateCondition(condition string, resolveTemplate func(string) (string, error)) (bool, error) {
+	if strings.TrimSpace(condition) == "" {
+		return true, nil
+	}
+
+	result, err := resolveTemplate("{{if " + condition + "}}true{{end}}")
+	if err != nil {
+		return false, fmt.Errorf("invalid condition '%s': %w", condition, err)
+	}
+
+	return result == "true", nil
+}
+
 func (self *HandlerCreator) inputPrompt(prompt *config.CustomCommandPrompt, wrappedF func(string) error) error {
 	findSuggestionsFn, err := self.generateFindSuggestionsFunc(prompt)
 	if err != nil {
@@ -229,19 +386,95 @@ func (self *HandlerCreator) menuPromptFromCommand(prompt *config.CustomCommandPr
 	return self.c.Menu(types.CreateMenuOptions{Title: prompt.Title, Items: menuItems})
 }
 
+func (self *HandlerCreator) checklistPrompt(prompt *config.CustomCommandPrompt, onConfirm func(values []string) error) error {
+	options := prompt.Options
+	if prompt.Command != "" {
+		output, err := self.c.Git().Custom.RunWithOutput(prompt.Command)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		candidates, err := self.menuGenerator.call(output, prompt.Filter, prompt.ValueFormat, prompt.LabelFormat)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		options = append(options, lo.Map(candidates, func(candidate *commandMenuItem, _ int) config.CustomCommandMenuOption {
+			return config.CustomCommandMenuOption{Name: candidate.label, Value: candidate.value}
+		})...)
+	}
+
+	items := make([]*helpers.ChecklistItem, 0, len(options))
+	valuesByItem := make(map[*helpers.ChecklistItem]string, len(options))
+	for _, option := range options {
+		item := &helpers.ChecklistItem{Label: option.Name, Description: option.Description}
+		items = append(items, item)
+		valuesByItem[item] = option.Value
+	}
+
+	return self.checklistHelper.Show(helpers.ChecklistOpts{
+		Title:        prompt.Title,
+		Items:        items,
+		ConfirmLabel: self.c.Tr.ContinueWithSelectedItems,
+		HandleConfirm: func(checked []*helpers.ChecklistItem) error {
+			return onConfirm(lo.Map(checked, func(item *helpers.ChecklistItem, _ int) string {
+				return valuesByItem[item]
+			}))
+		},
+	})
+}
+
+func (self *HandlerCreator) filePickerPrompt(prompt *config.CustomCommandPrompt, onConfirm func(string) error) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title:               prompt.Title,
+		InitialContent:      prompt.InitialValue,
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm:       onConfirm,
+	})
+}
+
+// uses the commit message panel, so that the first line and the rest of the
+// text are entered separately. They are joined the same way git joins the
+// subject and body of a commit message.
+func (self *HandlerCreator) multilineInputPrompt(prompt *config.CustomCommandPrompt, onConfirm func(string) error) error {
+	return self.commitsHelper.OpenCommitMessagePanel(
+		&helpers.OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   prompt.InitialValue,
+			SummaryTitle:     prompt.Title,
+			DescriptionTitle: self.c.Tr.MultilineInputBodyTitle,
+			PreserveMessage:  false,
+			OnConfirm: func(summary string, description string) error {
+				if description == "" {
+					return onConfirm(summary)
+				}
+				return onConfirm(summary + "\n\n" + description)
+			},
+		},
+	)
+}
+
 type CustomCommandObjects struct {
 	*SessionState
 	PromptResponses []string
 	Form            map[string]string
+	// The checked values of checklist prompts, by prompt key
+	FormLists map[string][]string
+	// The chosen element of the command's JSON output, for the actions of an
+	// outputMenu
+	Item any
 }
 
-func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, promptResponses []string, sessionState *SessionState) func(string) (string, error) {
-	objects := CustomCommandObjects{
+func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, formLists map[string][]string, promptResponses []string, sessionState *SessionState) func(string) (string, error) {
+	return self.resolveTemplateFn(CustomCommandObjects{
 		SessionState:    sessionState,
 		PromptResponses: promptResponses,
 		Form:            form,
-	}
+		FormLists:       formLists,
+	})
+}
 
+func (self *HandlerCreator) resolveTemplateFn(objects CustomCommandObjects) func(string) (string, error) {
 	funcs := template.FuncMap{
 		"quote": self.c.OS().Quote,
 	}
@@ -249,8 +482,22 @@ func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, promptR
 	return func(templateStr string) (string, error) { return utils.ResolveTemplate(templateStr, objects, funcs) }
 }
 
-func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, sessionState *SessionState, promptResponses []string, form map[string]string) error {
-	resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState)
+func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, sessionState *SessionState, promptResponses []string, form map[string]string, formLists map[string][]string) error {
+	// the steps may have done all the work already
+	if customCommand.Command == "" && len(customCommand.Steps) > 0 {
+		return nil
+	}
+
+	return self.runCommand(customCommand, CustomCommandObjects{
+		SessionState:    sessionState,
+		PromptResponses: promptResponses,
+		Form:            form,
+		FormLists:       formLists,
+	})
+}
+
+func (self *HandlerCreator) runCommand(customCommand config.CustomCommand, objects CustomCommandObjects) error {
+	resolveTemplate := self.resolveTemplateFn(objects)
 	cmdStr, err := resolveTemplate(customCommand.Command)
 	if err != nil {
 		return self.c.Error(err)
@@ -273,7 +520,14 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 		if customCommand.Stream {
 			cmdObj.StreamOutput()
 		}
-		output, err := cmdObj.RunWithOutput()
+
+		var output string
+		if customCommand.OutputMenu != nil {
+			// only stdout is expected to contain JSON
+			output, _, err = cmdObj.RunWithOutputs()
+		} else {
+			output, err = cmdObj.RunWithOutput()
+		}
 
 		if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
 			self.c.Log.Error(refreshErr)
@@ -287,6 +541,18 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 			return self.c.Error(err)
 		}
 
+		if customCommand.OutputMenu != nil {
+			items, err := parseJSONOutput(output)
+			if err != nil {
+				return self.c.Error(err)
+			}
+
+			self.c.OnUIThread(func() error {
+				return self.outputMenu(customCommand, objects, items)
+			})
+			return nil
+		}
+
 		if customCommand.ShowOutput {
 			if strings.TrimSpace(output) == "" {
 				output = self.c.Tr.EmptyOutput
@@ -297,3 +563,112 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 		return nil
 	})
 }
+
+func parseJSONOutput(output string) ([]any, error) {
+	var items []any
+	if err := json.Unmarshal([]byte(output), &items); err != nil {
+		return nil, fmt.Errorf("custom command output must be a JSON array: %w", err)
+	}
+
+	return items, nil
+}
+
+func (self *HandlerCreator) outputMenu(customCommand config.CustomCommand, objects CustomCommandObjects, items []any) error {
+	outputMenu := customCommand.OutputMenu
+
+	title := outputMenu.Title
+	if title == "" {
+		title = customCommand.Description
+	}
+	title, err := self.resolveTemplateFn(objects)(title)
+	if err != nil {
+		return self.c.Error(err)
+	}
+
+	menuItems := make([]*types.MenuItem, 0, len(items))
+	for _, item := range items {
+		itemObjects := objects
+		itemObjects.Item = item
+
+		labelColumns, err := self.outputMenuColumns(outputMenu, itemObjects)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: labelColumns,
+			OnPress: func() error {
+				return self.outputMenuActions(outputMenu, itemObjects)
+			},
+			OpensMenu: len(outputMenu.Actions) > 1,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
+}
+
+func (self *HandlerCreator) outputMenuColumns(outputMenu *config.CustomCommandOutputMenu, objects CustomCommandObjects) ([]string, error) {
+	if len(outputMenu.Columns) == 0 {
+		if str, ok := objects.Item.(string); ok {
+			return []string{str}, nil
+		}
+
+		encoded, err := json.Marshal(objects.Item)
+		if err != nil {
+			return nil, err
+		}
+		return []string{string(encoded)}, nil
+	}
+
+	resolveTemplate := self.resolveTemplateFn(objects)
+	columns := make([]string, 0, len(outputMenu.Columns))
+	for _, column := range outputMenu.Columns {
+		resolved, err := resolveTemplate(column)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, resolved)
+	}
+
+	return columns, nil
+}
+
+func (self *HandlerCreator) outputMenuActions(outputMenu *config.CustomCommandOutputMenu, objects CustomCommandObjects) error {
+	actionToCommand := func(action config.CustomCommandOutputAction) config.CustomCommand {
+		return config.CustomCommand{
+			Command:     action.Command,
+			Subprocess:  action.Subprocess,
+			LoadingText: action.LoadingText,
+			ShowOutput:  action.ShowOutput,
+		}
+	}
+
+	switch len(outputMenu.Actions) {
+	case 0:
+		return nil
+	case 1:
+		return self.runCommand(actionToCommand(outputMenu.Actions[0]), objects)
+	}
+
+	menuItems := lo.Map(outputMenu.Actions, func(action config.CustomCommandOutputAction, _ int) *types.MenuItem {
+		description := action.Description
+		if description == "" {
+			description = action.Command
+		}
+
+		var key types.Key
+		if action.Key != "" {
+			key = keybindings.GetKey(action.Key)
+		}
+
+		return &types.MenuItem{
+			Label: description,
+			Key:   key,
+			OnPress: func() error {
+				return self.runCommand(actionToCommand(action), objects)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.Actions.CustomCommand, Items: menuItems})
+}
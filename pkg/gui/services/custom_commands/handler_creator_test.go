@@ -0,0 +1,94 @@
+package custom_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONOutput(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		output        string
+		expectedItems []any
+		expectedError string
+	}{
+		{
+			testName:      "array of objects",
+			output:        `[{"id": 1, "title": "one"}, {"id": 2, "title": "two"}]`,
+			expectedItems: []any{map[string]any{"id": float64(1), "title": "one"}, map[string]any{"id": float64(2), "title": "two"}},
+		},
+		{
+			testName:      "array of strings with trailing newline",
+			output:        "[\"a\", \"b\"]\n",
+			expectedItems: []any{"a", "b"},
+		},
+		{
+			testName:      "empty array",
+			output:        "[]",
+			expectedItems: []any{},
+		},
+		{
+			testName:      "object instead of array",
+			output:        `{"id": 1}`,
+			expectedError: "custom command output must be a JSON array: json: cannot unmarshal object into Go value of type []interface {}",
+		},
+		{
+			testName:      "not JSON",
+			output:        "hello",
+			expectedError: "custom command output must be a JSON array: invalid character 'h' looking for beginning of value",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			items, err := parseJSONOutput(s.output)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedItems, items)
+			}
+		})
+	}
+}
+
+func TestEvaluateCondition(t *testing.T) {
+	objects := CustomCommandObjects{
+		Form: map[string]string{"Kind": "feature", "Empty": ""},
+	}
+	resolveTemplate := func(templateStr string) (string, error) {
+		return utils.ResolveTemplate(templateStr, objects, nil)
+	}
+
+	scenarios := []struct {
+		condition     string
+		expected      bool
+		expectedError string
+	}{
+		{condition: "", expected: true},
+		{condition: `eq .Form.Kind "feature"`, expected: true},
+		{condition: `eq .Form.Kind "hotfix"`, expected: false},
+		{condition: `ne .Form.Kind "hotfix"`, expected: true},
+		{condition: ".Form.Kind", expected: true},
+		{condition: ".Form.Empty", expected: false},
+		{condition: ".Form.Missing", expectedError: `map has no entry for key "Missing"`},
+		{condition: `and .Form.Kind (not .Form.Empty)`, expected: true},
+		{condition: "eq .Form.Kind", expectedError: "invalid condition 'eq .Form.Kind'"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.condition, func(t *testing.T) {
+			result, err := evaluateCondition(s.condition, resolveTemplate)
+			if s.expectedError != "" {
+				assert.ErrorContains(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+		})
+	}
+}
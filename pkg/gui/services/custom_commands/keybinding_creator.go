@@ -40,14 +40,33 @@ func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler
 	}
 
 	return &types.Binding{
-		ViewName:    viewName,
-		Key:         keybindings.GetKey(customCommand.Key),
-		Modifier:    gocui.ModNone,
-		Handler:     handler,
-		Description: description,
+		ViewName:          viewName,
+		Key:               keybindings.GetKey(customCommand.Key),
+		Modifier:          gocui.ModNone,
+		Handler:           handler,
+		Description:       description,
+		GetDisabledReason: self.getDisabledReason,
 	}, nil
 }
 
+// List panels only ever have a single item selected, which is what the
+// Selected* fields of the session state contain. The staging and patch
+// building views can select a range of lines though, which custom commands
+// have no way of seeing, so rather than acting on the whole file we refuse to
+// run.
+func (self *KeybindingCreator) getDisabledReason() string {
+	patchExplorerContext, ok := self.c.CurrentContext().(types.IPatchExplorerContext)
+	if !ok {
+		return ""
+	}
+
+	state := patchExplorerContext.GetState()
+	if state != nil && state.SelectingRange() {
+		return self.c.Tr.CustomCommandRangeSelectNotSupported
+	}
+	return ""
+}
+
 func (self *KeybindingCreator) getViewNameAndContexts(customCommand config.CustomCommand) (string, error) {
 	if customCommand.Context == "global" {
 		return "", nil
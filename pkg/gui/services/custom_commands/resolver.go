@@ -59,7 +59,7 @@ func (self *Resolver) resolvePrompt(
 		return nil, err
 	}
 
-	if prompt.Type == "menu" {
+	if prompt.Type == "menu" || prompt.Type == "checklist" {
 		result.Options, err = self.resolveMenuOptions(prompt, resolveTemplate)
 		if err != nil {
 			return nil, err
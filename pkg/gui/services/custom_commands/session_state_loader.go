@@ -3,6 +3,7 @@ package custom_commands
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 )
 
 // loads the session state at the time that a custom command is invoked, for use
@@ -35,23 +36,124 @@ type SessionState struct {
 	SelectedCommitFilePath string
 	SelectedWorktree       *models.Worktree
 	CheckedOutBranch       *models.Branch
+
+	// The selection of each panel as a list. For the files and commit files
+	// panels this contains every file below the selected directory; for the
+	// other panels it contains the selected item (if any), because list panels
+	// can't select a range of items.
+	SelectedLocalCommits   []*models.Commit
+	SelectedReflogCommits  []*models.Commit
+	SelectedSubCommits     []*models.Commit
+	SelectedFiles          []*models.File
+	SelectedLocalBranches  []*models.Branch
+	SelectedRemoteBranches []*models.RemoteBranch
+	SelectedRemotes        []*models.Remote
+	SelectedTags           []*models.Tag
+	SelectedStashEntries   []*models.StashEntry
+	SelectedCommitFiles    []*models.CommitFile
+	SelectedWorktrees      []*models.Worktree
+
+	// All items of the repo's models, as shown in the respective panels
+	LocalCommits   []*models.Commit
+	ReflogCommits  []*models.Commit
+	Files          []*models.File
+	LocalBranches  []*models.Branch
+	RemoteBranches []*models.RemoteBranch
+	Remotes        []*models.Remote
+	Tags           []*models.Tag
+	StashEntries   []*models.StashEntry
+	Worktrees      []*models.Worktree
+	Submodules     []*models.SubmoduleConfig
+
+	// Paths of the repo. RepoPath and WorktreePath only differ when in a
+	// linked worktree.
+	RepoName     string
+	RepoPath     string
+	WorktreePath string
+	GitDirPath   string
 }
 
-func (self *SessionStateLoader) call() *SessionState {
+func (self *SessionStateLoader) Call() *SessionState {
+	contexts := self.c.Contexts()
+	model := self.c.Model()
+	repoPaths := self.c.Git().RepoPaths
+
 	return &SessionState{
-		SelectedFile:           self.c.Contexts().Files.GetSelectedFile(),
-		SelectedPath:           self.c.Contexts().Files.GetSelectedPath(),
-		SelectedLocalCommit:    self.c.Contexts().LocalCommits.GetSelected(),
-		SelectedReflogCommit:   self.c.Contexts().ReflogCommits.GetSelected(),
-		SelectedLocalBranch:    self.c.Contexts().Branches.GetSelected(),
-		SelectedRemoteBranch:   self.c.Contexts().RemoteBranches.GetSelected(),
-		SelectedRemote:         self.c.Contexts().Remotes.GetSelected(),
-		SelectedTag:            self.c.Contexts().Tags.GetSelected(),
-		SelectedStashEntry:     self.c.Contexts().Stash.GetSelected(),
-		SelectedCommitFile:     self.c.Contexts().CommitFiles.GetSelectedFile(),
-		SelectedCommitFilePath: self.c.Contexts().CommitFiles.GetSelectedPath(),
-		SelectedSubCommit:      self.c.Contexts().SubCommits.GetSelected(),
-		SelectedWorktree:       self.c.Contexts().Worktrees.GetSelected(),
+		SelectedFile:           contexts.Files.GetSelectedFile(),
+		SelectedPath:           contexts.Files.GetSelectedPath(),
+		SelectedLocalCommit:    contexts.LocalCommits.GetSelected(),
+		SelectedReflogCommit:   contexts.ReflogCommits.GetSelected(),
+		SelectedLocalBranch:    contexts.Branches.GetSelected(),
+		SelectedRemoteBranch:   contexts.RemoteBranches.GetSelected(),
+		SelectedRemote:         contexts.Remotes.GetSelected(),
+		SelectedTag:            contexts.Tags.GetSelected(),
+		SelectedStashEntry:     contexts.Stash.GetSelected(),
+		SelectedCommitFile:     contexts.CommitFiles.GetSelectedFile(),
+		SelectedCommitFilePath: contexts.CommitFiles.GetSelectedPath(),
+		SelectedSubCommit:      contexts.SubCommits.GetSelected(),
+		SelectedWorktree:       contexts.Worktrees.GetSelected(),
 		CheckedOutBranch:       self.refsHelper.GetCheckedOutRef(),
+
+		SelectedLocalCommits:   selection(contexts.LocalCommits.GetSelected()),
+		SelectedReflogCommits:  selection(contexts.ReflogCommits.GetSelected()),
+		SelectedSubCommits:     selection(contexts.SubCommits.GetSelected()),
+		SelectedFiles:          filesBelow(contexts.Files.GetSelected()),
+		SelectedLocalBranches:  selection(contexts.Branches.GetSelected()),
+		SelectedRemoteBranches: selection(contexts.RemoteBranches.GetSelected()),
+		SelectedRemotes:        selection(contexts.Remotes.GetSelected()),
+		SelectedTags:           selection(contexts.Tags.GetSelected()),
+		SelectedStashEntries:   selection(contexts.Stash.GetSelected()),
+		SelectedCommitFiles:    commitFilesBelow(contexts.CommitFiles.GetSelected()),
+		SelectedWorktrees:      selection(contexts.Worktrees.GetSelected()),
+
+		LocalCommits:   model.Commits,
+		ReflogCommits:  model.FilteredReflogCommits,
+		Files:          model.Files,
+		LocalBranches:  model.Branches,
+		RemoteBranches: model.RemoteBranches,
+		Remotes:        model.Remotes,
+		Tags:           model.Tags,
+		StashEntries:   model.StashEntries,
+		Worktrees:      model.Worktrees,
+		Submodules:     model.Submodules,
+
+		RepoName:     repoPaths.RepoName(),
+		RepoPath:     repoPaths.RepoPath(),
+		WorktreePath: repoPaths.WorktreePath(),
+		GitDirPath:   repoPaths.WorktreeGitDirPath(),
+	}
+}
+
+func selection[T any](item *T) []*T {
+	if item == nil {
+		return []*T{}
 	}
+
+	return []*T{item}
+}
+
+func filesBelow(node *filetree.FileNode) []*models.File {
+	files := []*models.File{}
+	if node == nil {
+		return files
+	}
+
+	_ = node.ForEachFile(func(file *models.File) error {
+		files = append(files, file)
+		return nil
+	})
+	return files
+}
+
+func commitFilesBelow(node *filetree.CommitFileNode) []*models.CommitFile {
+	files := []*models.CommitFile{}
+	if node == nil {
+		return files
+	}
+
+	_ = node.ForEachFile(func(file *models.CommitFile) error {
+		files = append(files, file)
+		return nil
+	})
+	return files
 }
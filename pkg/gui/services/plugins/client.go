@@ -0,0 +1,197 @@
+package plugins
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/services/custom_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
+	"github.com/sirupsen/logrus"
+)
+
+// Client is the entry point to this package. It starts the plugins configured
+// in the user config in the background and returns keybindings for what they
+// register once they're running.
+// See https://github.com/jesseduffield/lazygit/blob/master/docs/Plugins.md for more info.
+type Client struct {
+	c *helpers.HelperCommon
+	// helpers are recreated when switching repos, whereas plugins keep running
+	getHelpers func() *helpers.Helpers
+
+	plugins      []*Plugin
+	pluginsMutex *deadlock.Mutex
+}
+
+func NewClient(c *helpers.HelperCommon, getHelpers func() *helpers.Helpers) *Client {
+	return &Client{
+		c:            c,
+		getHelpers:   getHelpers,
+		pluginsMutex: &deadlock.Mutex{},
+	}
+}
+
+// Returns the keybindings of the plugins that have started so far
+func (self *Client) GetPluginKeybindings() []*types.Binding {
+	bindings := []*types.Binding{}
+	hasMenuItems := false
+	for _, plugin := range self.getPlugins() {
+		plugin := plugin
+		for _, registration := range plugin.registration.Keybindings {
+			registration := registration
+			viewName, ok := self.viewNameForContext(registration.Context)
+			if !ok {
+				self.c.Log.Errorf("plugin '%s' registered keybinding '%s' for unknown context '%s'", plugin.name, registration.ID, registration.Context)
+				continue
+			}
+			if err := keybindings.ValidateKey(registration.Key); err != nil {
+				self.c.Log.Errorf("plugin '%s' registered keybinding '%s' with invalid key: %v", plugin.name, registration.ID, err)
+				continue
+			}
+
+			bindings = append(bindings, &types.Binding{
+				ViewName:    viewName,
+				Key:         keybindings.GetKey(registration.Key),
+				Modifier:    gocui.ModNone,
+				Handler:     func() error { return self.invoke(plugin, registration.ID) },
+				Description: registration.Description,
+			})
+		}
+
+		hasMenuItems = hasMenuItems || len(plugin.registration.MenuItems) > 0
+	}
+
+	if hasMenuItems {
+		bindings = append(bindings, &types.Binding{
+			ViewName:    "",
+			Key:         keybindings.GetKey(self.c.UserConfig.Keybinding.Universal.OpenPluginsMenu),
+			Modifier:    gocui.ModNone,
+			Handler:     self.openPluginsMenu,
+			Description: self.c.Tr.OpenPluginsMenu,
+			OpensMenu:   true,
+		})
+	}
+
+	return bindings
+}
+
+// Starts the configured plugins without blocking the UI, since each of them
+// can take a while to initialize. onStarted is called on the UI thread once
+// they're running, so that their keybindings can be bound.
+func (self *Client) Start(onStarted func() error) {
+	if len(self.c.UserConfig.Plugins) == 0 {
+		return
+	}
+
+	repoPath := self.c.Git().RepoPaths.RepoPath()
+	self.c.OnWorker(func(gocui.Task) {
+		for _, pluginConfig := range self.c.UserConfig.Plugins {
+			cmd := self.c.OS().Cmd.NewShell(pluginConfig.Command).GetCmd()
+			stderr := self.c.Log.WithField("plugin", pluginConfig.Name).WriterLevel(logrus.InfoLevel)
+			params := InitializeParams{
+				ProtocolVersion: protocolVersion,
+				RepoPath:        repoPath,
+			}
+
+			plugin, err := startPlugin(pluginConfig, cmd, stderr, params, self.requestHandler)
+			if err != nil {
+				self.c.Log.Error(err)
+				self.c.OnUIThread(func() error { return self.c.Error(err) })
+				continue
+			}
+
+			self.pluginsMutex.Lock()
+			self.plugins = append(self.plugins, plugin)
+			self.pluginsMutex.Unlock()
+		}
+
+		self.c.OnUIThread(onStarted)
+	})
+}
+
+// Tells the plugins about the repo we've switched to
+func (self *Client) OnRepoChanged() {
+	params := RepoChangedParams{RepoPath: self.c.Git().RepoPaths.RepoPath()}
+	for _, plugin := range self.getPlugins() {
+		if err := plugin.conn.Notify("repoChanged", params); err != nil {
+			self.c.Log.Errorf("plugin '%s': %v", plugin.name, err)
+		}
+	}
+}
+
+func (self *Client) Stop() {
+	self.pluginsMutex.Lock()
+	plugins := self.plugins
+	self.plugins = nil
+	self.pluginsMutex.Unlock()
+
+	for _, plugin := range plugins {
+		plugin.stop()
+	}
+}
+
+func (self *Client) getPlugins() []*Plugin {
+	self.pluginsMutex.Lock()
+	defer self.pluginsMutex.Unlock()
+
+	return self.plugins
+}
+
+func (self *Client) invoke(plugin *Plugin, id string) error {
+	state := self.sessionState()
+
+	self.c.OnWorker(func(task gocui.Task) {
+		if err := plugin.invoke(task, id, state); err != nil {
+			self.c.OnUIThread(func() error {
+				return self.c.Error(fmt.Errorf("plugin '%s': %w", plugin.name, err))
+			})
+		}
+	})
+
+	return nil
+}
+
+func (self *Client) sessionState() *custom_commands.SessionState {
+	return custom_commands.NewSessionStateLoader(self.c, self.getHelpers().Refs).Call()
+}
+
+func (self *Client) openPluginsMenu() error {
+	currentContextKey := string(self.c.CurrentSideContext().GetKey())
+
+	menuItems := []*types.MenuItem{}
+	for _, plugin := range self.getPlugins() {
+		plugin := plugin
+		for _, registration := range plugin.registration.MenuItems {
+			registration := registration
+			if registration.Context != "global" && registration.Context != currentContextKey {
+				continue
+			}
+
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{registration.Label, style.FgYellow.Sprint(registration.Description)},
+				OnPress:      func() error { return self.invoke(plugin, registration.ID) },
+			})
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PluginsMenuTitle, Items: menuItems})
+}
+
+func (self *Client) viewNameForContext(contextKey string) (string, bool) {
+	if contextKey == "global" {
+		return "", true
+	}
+
+	context, ok := lo.Find(self.c.Contexts().Flatten(), func(context types.Context) bool {
+		return string(context.GetKey()) == contextKey
+	})
+	if !ok {
+		return "", false
+	}
+
+	return context.GetViewName(), true
+}
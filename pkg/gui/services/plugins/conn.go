@@ -0,0 +1,236 @@
+package plugins
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Plugins talk JSON-RPC 2.0 over their stdin/stdout, with one message per line.
+// Both sides can send requests: lazygit asks the plugin to initialize and to
+// invoke its keybindings, and the plugin asks lazygit to show popups, run git
+// commands etc.
+
+const jsonrpcVersion = "2.0"
+
+// standard JSON-RPC error codes, plus one of our own for failed actions
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeActionFailed   = -32000
+)
+
+var errConnClosed = errors.New("plugin connection closed")
+
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *RPCError        `json:"error,omitempty"`
+}
+
+func (self *message) isRequest() bool {
+	return self.Method != ""
+}
+
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (self *RPCError) Error() string {
+	return self.Message
+}
+
+// Handles a request or notification from the other side. For requests, reply
+// must be called exactly once, possibly asynchronously; for notifications it
+// does nothing.
+type RequestHandler func(method string, params json.RawMessage, reply func(result any, err error))
+
+type Conn struct {
+	writer     io.Writer
+	writeMutex *deadlock.Mutex
+
+	handler RequestHandler
+
+	pendingMutex *deadlock.Mutex
+	nextID       int
+	pending      map[string]chan *message
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Starts reading messages from reader in the background. The connection is
+// closed once reader hits EOF.
+func NewConn(reader io.Reader, writer io.Writer, handler RequestHandler) *Conn {
+	conn := &Conn{
+		writer:       writer,
+		writeMutex:   &deadlock.Mutex{},
+		handler:      handler,
+		pendingMutex: &deadlock.Mutex{},
+		nextID:       1,
+		pending:      map[string]chan *message{},
+		closed:       make(chan struct{}),
+	}
+
+	go conn.readLoop(reader)
+
+	return conn
+}
+
+// Sends a request and blocks until the response arrives, decoding its result
+// into result (unless result is nil).
+func (self *Conn) Call(method string, params any, result any) error {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	self.pendingMutex.Lock()
+	id := json.RawMessage(strconv.Itoa(self.nextID))
+	self.nextID++
+	responseChan := make(chan *message, 1)
+	self.pending[string(id)] = responseChan
+	self.pendingMutex.Unlock()
+
+	if err := self.write(&message{JSONRPC: jsonrpcVersion, ID: &id, Method: method, Params: rawParams}); err != nil {
+		self.removePending(string(id))
+		return err
+	}
+
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return response.Error
+		}
+		if result != nil && len(response.Result) > 0 {
+			return json.Unmarshal(response.Result, result)
+		}
+		return nil
+	case <-self.closed:
+		self.removePending(string(id))
+		return errConnClosed
+	}
+}
+
+// Sends a notification, which the other side doesn't reply to
+func (self *Conn) Notify(method string, params any) error {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return self.write(&message{JSONRPC: jsonrpcVersion, Method: method, Params: rawParams})
+}
+
+func (self *Conn) Closed() <-chan struct{} {
+	return self.closed
+}
+
+func (self *Conn) close() {
+	self.closeOnce.Do(func() { close(self.closed) })
+}
+
+func (self *Conn) removePending(id string) {
+	self.pendingMutex.Lock()
+	defer self.pendingMutex.Unlock()
+
+	delete(self.pending, id)
+}
+
+func (self *Conn) write(msg *message) error {
+	encoded, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	self.writeMutex.Lock()
+	defer self.writeMutex.Unlock()
+
+	select {
+	case <-self.closed:
+		return errConnClosed
+	default:
+	}
+
+	_, err = self.writer.Write(append(encoded, '\n'))
+	return err
+}
+
+func (self *Conn) readLoop(reader io.Reader) {
+	defer self.close()
+
+	scanner := bufio.NewScanner(reader)
+	// git output passed back and forth can make for long lines
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var msg message
+		if err := json.Unmarshal(line, &msg); err != nil {
+			_ = self.write(&message{
+				JSONRPC: jsonrpcVersion,
+				ID:      &json.RawMessage{'n', 'u', 'l', 'l'},
+				Error:   &RPCError{Code: codeParseError, Message: fmt.Sprintf("invalid message: %v", err)},
+			})
+			continue
+		}
+
+		if msg.isRequest() {
+			self.handleRequest(&msg)
+		} else if msg.ID != nil {
+			self.pendingMutex.Lock()
+			responseChan, ok := self.pending[string(*msg.ID)]
+			delete(self.pending, string(*msg.ID))
+			self.pendingMutex.Unlock()
+
+			if ok {
+				responseChan <- &msg
+			}
+		}
+	}
+}
+
+func (self *Conn) handleRequest(msg *message) {
+	id := msg.ID
+	reply := func(result any, err error) {
+		// notifications get no reply
+		if id == nil {
+			return
+		}
+
+		response := &message{JSONRPC: jsonrpcVersion, ID: id}
+		if err != nil {
+			rpcErr := &RPCError{}
+			if !errors.As(err, &rpcErr) {
+				rpcErr = &RPCError{Code: codeActionFailed, Message: err.Error()}
+			}
+			response.Error = rpcErr
+		} else {
+			encoded, marshalErr := json.Marshal(result)
+			if marshalErr != nil {
+				response.Error = &RPCError{Code: codeActionFailed, Message: marshalErr.Error()}
+			} else {
+				response.Result = encoded
+			}
+		}
+
+		_ = self.write(response)
+	}
+
+	self.handler(msg.Method, msg.Params, reply)
+}
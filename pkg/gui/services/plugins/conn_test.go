@@ -0,0 +1,163 @@
+package plugins
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the other end of a Conn, reading and writing raw lines
+type fakePeer struct {
+	lines  *bufio.Scanner
+	writer io.WriteCloser
+}
+
+func newConnWithPeer(handler RequestHandler) (*Conn, *fakePeer) {
+	connReader, peerWriter := io.Pipe()
+	peerReader, connWriter := io.Pipe()
+
+	conn := NewConn(connReader, connWriter, handler)
+	return conn, &fakePeer{lines: bufio.NewScanner(peerReader), writer: peerWriter}
+}
+
+func (self *fakePeer) readLine(t *testing.T) string {
+	t.Helper()
+	if !self.lines.Scan() {
+		t.Fatal("expected a line from the connection")
+	}
+	return self.lines.Text()
+}
+
+func (self *fakePeer) writeLine(t *testing.T, line string) {
+	t.Helper()
+	if _, err := self.writer.Write([]byte(line + "\n")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConnCall(t *testing.T) {
+	conn, peer := newConnWithPeer(nil)
+
+	type result struct {
+		Greeting string `json:"greeting"`
+	}
+
+	done := make(chan error)
+	var actual result
+	go func() {
+		done <- conn.Call("hello", map[string]string{"name": "world"}, &actual)
+	}()
+
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"method":"hello","params":{"name":"world"}}`, peer.readLine(t))
+	peer.writeLine(t, `{"jsonrpc":"2.0","id":1,"result":{"greeting":"hi"}}`)
+
+	assert.NoError(t, <-done)
+	assert.Equal(t, result{Greeting: "hi"}, actual)
+}
+
+func TestConnCallError(t *testing.T) {
+	conn, peer := newConnWithPeer(nil)
+
+	done := make(chan error)
+	go func() {
+		done <- conn.Call("hello", nil, nil)
+	}()
+
+	peer.readLine(t)
+	peer.writeLine(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"it broke"}}`)
+
+	err := <-done
+	assert.EqualError(t, err, "it broke")
+	assert.Equal(t, &RPCError{Code: codeActionFailed, Message: "it broke"}, err)
+}
+
+func TestConnCallAfterClose(t *testing.T) {
+	conn, peer := newConnWithPeer(nil)
+
+	done := make(chan error)
+	go func() {
+		done <- conn.Call("hello", nil, nil)
+	}()
+
+	peer.readLine(t)
+	assert.NoError(t, peer.writer.Close())
+
+	assert.Equal(t, errConnClosed, <-done)
+	<-conn.Closed()
+	assert.Equal(t, errConnClosed, conn.Notify("hello", nil))
+}
+
+func TestConnNotify(t *testing.T) {
+	conn, peer := newConnWithPeer(nil)
+
+	go func() {
+		_ = conn.Notify("shutdown", nil)
+	}()
+
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"shutdown","params":null}`, peer.readLine(t))
+}
+
+func TestConnHandleRequest(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		request          string
+		expectedResponse string
+	}{
+		{
+			testName:         "result",
+			request:          `{"jsonrpc":"2.0","id":"a","method":"echo","params":{"value":"x"}}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":"a","result":{"value":"x"}}`,
+		},
+		{
+			testName:         "plain error",
+			request:          `{"jsonrpc":"2.0","id":2,"method":"fail"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"it broke"}}`,
+		},
+		{
+			testName:         "rpc error",
+			request:          `{"jsonrpc":"2.0","id":3,"method":"unknown"}`,
+			expectedResponse: `{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"unknown method"}}`,
+		},
+		{
+			testName:         "invalid json",
+			request:          `{"jsonrpc"`,
+			expectedResponse: `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"invalid message: unexpected end of JSON input"}}`,
+		},
+	}
+
+	handler := func(method string, params json.RawMessage, reply func(any, error)) {
+		switch method {
+		case "echo":
+			reply(params, nil)
+		case "fail":
+			reply(nil, errors.New("it broke"))
+		default:
+			reply(nil, &RPCError{Code: codeMethodNotFound, Message: "unknown method"})
+		}
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			_, peer := newConnWithPeer(handler)
+			peer.writeLine(t, s.request)
+			assert.JSONEq(t, s.expectedResponse, peer.readLine(t))
+		})
+	}
+}
+
+func TestConnHandleNotification(t *testing.T) {
+	received := make(chan string, 1)
+	handler := func(method string, params json.RawMessage, reply func(any, error)) {
+		reply("ignored", nil)
+		received <- method
+	}
+
+	_, peer := newConnWithPeer(handler)
+	peer.writeLine(t, `{"jsonrpc":"2.0","method":"refresh"}`)
+	assert.Equal(t, "refresh", <-received)
+}
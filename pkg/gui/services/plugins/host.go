@@ -0,0 +1,240 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The requests a plugin can send to lazygit. See docs/Plugins.md.
+
+type ToastParams struct {
+	Message string `json:"message"`
+}
+
+type AlertParams struct {
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
+type ConfirmResult struct {
+	Confirmed bool `json:"confirmed"`
+}
+
+type PromptParams struct {
+	Title        string `json:"title"`
+	InitialValue string `json:"initialValue"`
+}
+
+type PromptResult struct {
+	Value     string `json:"value"`
+	Cancelled bool   `json:"cancelled"`
+}
+
+type MenuParams struct {
+	Title string           `json:"title"`
+	Items []MenuItemParams `json:"items"`
+}
+
+type MenuItemParams struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Value       string `json:"value"`
+}
+
+type MenuResult struct {
+	Value     string `json:"value"`
+	Cancelled bool   `json:"cancelled"`
+}
+
+type RunGitCommandParams struct {
+	Args []string `json:"args"`
+}
+
+type RunGitCommandResult struct {
+	Output string `json:"output"`
+}
+
+type RenderMainParams struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+func (self *Client) requestHandler(plugin *Plugin) RequestHandler {
+	return func(method string, rawParams json.RawMessage, reply func(result any, err error)) {
+		decode := func(params any) bool {
+			if len(rawParams) == 0 {
+				return true
+			}
+			if err := json.Unmarshal(rawParams, params); err != nil {
+				reply(nil, &RPCError{Code: codeInvalidParams, Message: err.Error()})
+				return false
+			}
+			return true
+		}
+
+		switch method {
+		case "getState":
+			// the contexts may only be read from the UI thread
+			self.c.OnUIThread(func() error {
+				reply(self.sessionState(), nil)
+				return nil
+			})
+
+		case "refresh":
+			reply(nil, self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}))
+
+		case "showToast":
+			var params ToastParams
+			if decode(&params) {
+				self.c.Toast(params.Message)
+				reply(nil, nil)
+			}
+
+		case "alert":
+			var params AlertParams
+			if decode(&params) {
+				self.c.OnUIThread(func() error {
+					reply(nil, nil)
+					return self.c.Alert(params.Title, params.Message)
+				})
+			}
+
+		case "confirm":
+			var params AlertParams
+			if decode(&params) {
+				self.c.OnUIThread(func() error {
+					reply := waitingForUser(plugin, reply)
+					return self.c.Confirm(types.ConfirmOpts{
+						Title:  params.Title,
+						Prompt: params.Message,
+						HandleConfirm: func() error {
+							reply(ConfirmResult{Confirmed: true}, nil)
+							return nil
+						},
+						HandleClose: func() error {
+							reply(ConfirmResult{Confirmed: false}, nil)
+							return nil
+						},
+					})
+				})
+			}
+
+		case "prompt":
+			var params PromptParams
+			if decode(&params) {
+				self.c.OnUIThread(func() error {
+					reply := waitingForUser(plugin, reply)
+					return self.c.Prompt(types.PromptOpts{
+						Title:          params.Title,
+						InitialContent: params.InitialValue,
+						HandleConfirm: func(value string) error {
+							reply(PromptResult{Value: value}, nil)
+							return nil
+						},
+						HandleClose: func() error {
+							reply(PromptResult{Cancelled: true}, nil)
+							return nil
+						},
+					})
+				})
+			}
+
+		case "menu":
+			var params MenuParams
+			if decode(&params) {
+				self.c.OnUIThread(func() error {
+					return self.showMenu(params, waitingForUser(plugin, reply))
+				})
+			}
+
+		case "runGitCommand":
+			var params RunGitCommandParams
+			if decode(&params) {
+				output, err := self.runGitCommand(plugin, params.Args)
+				if err != nil {
+					reply(nil, err)
+				} else {
+					reply(RunGitCommandResult{Output: output}, nil)
+				}
+			}
+
+		case "renderMain":
+			var params RenderMainParams
+			if decode(&params) {
+				self.c.OnUIThread(func() error {
+					reply(nil, nil)
+					return self.c.RenderToMainViews(types.RefreshMainOpts{
+						Pair: self.c.MainViewPairs().Normal,
+						Main: &types.ViewUpdateOpts{
+							Title: params.Title,
+							Task:  types.NewRenderStringTask(params.Content),
+						},
+					})
+				})
+			}
+
+		default:
+			reply(nil, &RPCError{Code: codeMethodNotFound, Message: fmt.Sprintf("unknown method '%s'", method)})
+		}
+	}
+}
+
+// Wraps reply so that lazygit counts as idle until the user has answered the
+// popup that the plugin is waiting on
+func waitingForUser(plugin *Plugin, reply func(result any, err error)) func(result any, err error) {
+	done := plugin.waitForUser()
+	return func(result any, err error) {
+		done()
+		reply(result, err)
+	}
+}
+
+// Choosing the cancel item or closing the menu with escape replies with a
+// cancelled result
+func (self *Client) showMenu(params MenuParams, reply func(result any, err error)) error {
+	menuItems := lo.Map(params.Items, func(item MenuItemParams, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{item.Label, item.Description},
+			OnPress: func() error {
+				reply(MenuResult{Value: item.Value}, nil)
+				return nil
+			},
+		}
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label: self.c.Tr.Cancel,
+		OnPress: func() error {
+			reply(MenuResult{Cancelled: true}, nil)
+			return nil
+		},
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:      params.Title,
+		Items:      menuItems,
+		HideCancel: true,
+		HandleClose: func() error {
+			reply(MenuResult{Cancelled: true}, nil)
+			return nil
+		},
+	})
+}
+
+// runs the command such that it shows up in the command log, like lazygit's
+// own commands
+func (self *Client) runGitCommand(plugin *Plugin, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", &RPCError{Code: codeInvalidParams, Message: "args must not be empty"}
+	}
+
+	self.c.LogAction(utils.ResolvePlaceholderString(self.c.Tr.Actions.PluginCommand, map[string]string{"plugin": plugin.name}))
+
+	cmdArgs := git_commands.NewGitCmd(args[0]).Arg(args[1:]...).ToArgv()
+	return self.c.OS().Cmd.New(cmdArgs).RunWithOutput()
+}
@@ -0,0 +1,168 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// version of the protocol described in docs/Plugins.md, sent to plugins on
+// initialization
+const protocolVersion = 1
+
+const (
+	initializeTimeout = 5 * time.Second
+	shutdownTimeout   = 500 * time.Millisecond
+)
+
+type InitializeParams struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	RepoPath        string `json:"repoPath"`
+}
+
+type RepoChangedParams struct {
+	RepoPath string `json:"repoPath"`
+}
+
+// What a plugin registers in response to the initialize request
+type Registration struct {
+	Keybindings []KeybindingRegistration `json:"keybindings"`
+	MenuItems   []MenuItemRegistration   `json:"menuItems"`
+}
+
+type KeybindingRegistration struct {
+	// Passed back to the plugin when the keybinding is pressed
+	ID string `json:"id"`
+	// Same values as for custom commands, e.g. 'files' or 'global'
+	Context     string `json:"context"`
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+type MenuItemRegistration struct {
+	// Passed back to the plugin when the menu item is chosen
+	ID string `json:"id"`
+	// Same values as for custom commands, e.g. 'files' or 'global'
+	Context     string `json:"context"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+type InvokeParams struct {
+	ID    string `json:"id"`
+	State any    `json:"state"`
+}
+
+type Plugin struct {
+	name         string
+	cmd          *exec.Cmd
+	stdin        io.WriteCloser
+	conn         *Conn
+	registration *Registration
+
+	// the task of the invocation in progress, if any
+	task      gocui.Task
+	taskMutex *deadlock.Mutex
+}
+
+// starts the plugin's process and waits for it to register its keybindings and
+// menu items
+func startPlugin(
+	pluginConfig config.PluginConfig,
+	cmd *exec.Cmd,
+	stderr io.Writer,
+	params InitializeParams,
+	handler func(*Plugin) RequestHandler,
+) (*Plugin, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stderr = stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	plugin := &Plugin{name: pluginConfig.Name, cmd: cmd, stdin: stdin, taskMutex: &deadlock.Mutex{}}
+	plugin.conn = NewConn(stdout, stdin, handler(plugin))
+
+	registration := &Registration{}
+	initialized := make(chan error, 1)
+	go func() {
+		initialized <- plugin.conn.Call("initialize", params, registration)
+	}()
+
+	select {
+	case err = <-initialized:
+	case <-time.After(initializeTimeout):
+		err = errors.New("timed out waiting for the plugin to initialize")
+	}
+
+	if err != nil {
+		plugin.stop()
+		return nil, fmt.Errorf("plugin '%s' failed to start: %w", pluginConfig.Name, err)
+	}
+
+	plugin.registration = registration
+	return plugin, nil
+}
+
+// lazygit counts as busy until the plugin replies, except while it's waiting
+// for the user (see waitForUser)
+func (self *Plugin) invoke(task gocui.Task, id string, state any) error {
+	self.setTask(task)
+	defer self.setTask(nil)
+
+	return self.conn.Call("invoke", InvokeParams{ID: id, State: state}, nil)
+}
+
+func (self *Plugin) setTask(task gocui.Task) {
+	self.taskMutex.Lock()
+	defer self.taskMutex.Unlock()
+
+	self.task = task
+}
+
+// Pauses the current invocation's task until the returned function is called,
+// so that lazygit isn't considered busy while a popup is waiting for the user
+func (self *Plugin) waitForUser() func() {
+	self.taskMutex.Lock()
+	task := self.task
+	self.taskMutex.Unlock()
+
+	if task == nil {
+		return func() {}
+	}
+
+	task.Pause()
+	return task.Continue
+}
+
+// Asks the plugin to exit by closing its stdin, and kills it if it doesn't
+func (self *Plugin) stop() {
+	_ = self.conn.Notify("shutdown", nil)
+	_ = self.stdin.Close()
+
+	exited := make(chan struct{})
+	go func() {
+		_ = self.cmd.Wait()
+		close(exited)
+	}()
+
+	select {
+	case <-exited:
+	case <-time.After(shutdownTimeout):
+		_ = self.cmd.Process.Kill()
+	}
+}
@@ -1,6 +1,7 @@
 package status
 
 import (
+	"sync"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -23,10 +24,12 @@ type WaitingStatusHandle struct {
 	message       string
 	renderFunc    func()
 	id            int
+	cancelled     chan struct{}
+	cancelOnce    sync.Once
 }
 
 func (self *WaitingStatusHandle) Show() {
-	self.id = self.statusManager.addStatus(self.message, "waiting")
+	self.id = self.statusManager.addStatus(self.message, "waiting", self)
 	self.renderFunc()
 }
 
@@ -34,10 +37,27 @@ func (self *WaitingStatusHandle) Hide() {
 	self.statusManager.removeStatus(self.id)
 }
 
+// Shows the progress of the operation after the status message, e.g.
+// 'Fetching - origin: Receiving objects 45% (450/1000)'
+func (self *WaitingStatusHandle) SetProgress(progress string) {
+	self.statusManager.setStatusMessage(self.id, self.message+" - "+progress)
+}
+
+// Closed when the user cancels the operation that the status is shown for
+func (self *WaitingStatusHandle) Cancelled() <-chan struct{} {
+	return self.cancelled
+}
+
+func (self *WaitingStatusHandle) cancel() {
+	self.cancelOnce.Do(func() { close(self.cancelled) })
+}
+
 type appStatus struct {
 	message    string
 	statusType string
 	id         int
+	// only set for waiting statuses
+	handle *WaitingStatusHandle
 }
 
 func NewStatusManager() *StatusManager {
@@ -45,7 +65,7 @@ func NewStatusManager() *StatusManager {
 }
 
 func (self *StatusManager) WithWaitingStatus(message string, renderFunc func(), f func(*WaitingStatusHandle)) {
-	handle := &WaitingStatusHandle{statusManager: self, message: message, renderFunc: renderFunc, id: -1}
+	handle := &WaitingStatusHandle{statusManager: self, message: message, renderFunc: renderFunc, id: -1, cancelled: make(chan struct{})}
 	handle.Show()
 
 	f(handle)
@@ -54,7 +74,7 @@ func (self *StatusManager) WithWaitingStatus(message string, renderFunc func(),
 }
 
 func (self *StatusManager) AddToastStatus(message string) int {
-	id := self.addStatus(message, "toast")
+	id := self.addStatus(message, "toast", nil)
 
 	go func() {
 		time.Sleep(time.Second * 2)
@@ -76,11 +96,28 @@ func (self *StatusManager) GetStatusString() string {
 	return topStatus.message
 }
 
+// Cancels the operation of the waiting status that is currently shown, if
+// there is one. Returns false if there isn't.
+func (self *StatusManager) CancelWaitingStatus() bool {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	status, found := lo.Find(self.statuses, func(status appStatus) bool {
+		return status.statusType == "waiting"
+	})
+	if !found {
+		return false
+	}
+
+	status.handle.cancel()
+	return true
+}
+
 func (self *StatusManager) HasStatus() bool {
 	return len(self.statuses) > 0
 }
 
-func (self *StatusManager) addStatus(message string, statusType string) int {
+func (self *StatusManager) addStatus(message string, statusType string, handle *WaitingStatusHandle) int {
 	self.mutex.Lock()
 	defer self.mutex.Unlock()
 
@@ -91,12 +128,24 @@ func (self *StatusManager) addStatus(message string, statusType string) int {
 		message:    message,
 		statusType: statusType,
 		id:         id,
+		handle:     handle,
 	}
 	self.statuses = append([]appStatus{newStatus}, self.statuses...)
 
 	return id
 }
 
+func (self *StatusManager) setStatusMessage(id int, message string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for i := range self.statuses {
+		if self.statuses[i].id == id {
+			self.statuses[i].message = message
+		}
+	}
+}
+
 func (self *StatusManager) removeStatus(id int) {
 	self.mutex.Lock()
 	defer self.mutex.Unlock()
@@ -6,10 +6,35 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
 func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	return gui.newCmdTaskWithReader(view, cmd, prefix, func(r io.Reader) io.Reader { return r })
+}
+
+// Renders the diff that the command outputs with the old and the new version
+// next to each other, fitting the width of the view
+func (gui *Gui) newSideBySideDiffTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+	width := view.InnerWidth()
+	wordDiff := patch.WordDiffMode(gui.c.GetAppState().WordDiffMode)
+
+	return gui.newCmdTaskWithReader(view, cmd, prefix, func(r io.Reader) io.Reader {
+		return patch.NewSideBySideReader(r, width, wordDiff)
+	})
+}
+
+// Renders the diff that the command outputs with the changes within its lines
+// highlighted, keeping each line of the diff on a line of the view
+func (gui *Gui) newWordDiffTask(view *gocui.View, cmd *exec.Cmd, prefix string, wordDiff patch.WordDiffMode) error {
+	return gui.newCmdTaskWithReader(view, cmd, prefix, func(r io.Reader) io.Reader {
+		return patch.NewWordDiffReader(r, wordDiff)
+	})
+}
+
+func (gui *Gui) newCmdTaskWithReader(view *gocui.View, cmd *exec.Cmd, prefix string, wrapReader func(io.Reader) io.Reader) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -29,7 +54,7 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.c.Log.Error(err)
 		}
 
-		return cmd, r
+		return cmd, wrapReader(r)
 	}
 
 	linesToRead := gui.linesToReadFromCmdTask(view)
@@ -94,6 +119,16 @@ func (gui *Gui) newStringTaskWithKey(view *gocui.View, str string, key string) e
 	return nil
 }
 
+func (gui *Gui) newDeferredTask(view *gocui.View, getTask func() types.UpdateTask) error {
+	manager := gui.getManager(view)
+
+	f := func(tasks.TaskOpts) error {
+		return gui.runTaskForView(view, getTask())
+	}
+
+	return manager.NewTask(f, "")
+}
+
 func (gui *Gui) getManager(view *gocui.View) *tasks.ViewBufferManager {
 	manager, ok := gui.viewBufferManagerMap[view.Name()]
 	if !ok {
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/journal"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 	"gopkg.in/ozeidan/fuzzy-patricia.v3/patricia"
@@ -114,6 +115,7 @@ type IGuiCommon interface {
 
 	// hopefully we can remove this once we've moved all our keybinding stuff out of the gui god struct.
 	GetInitialKeybindingsWithCustomCommands() ([]*Binding, []*gocui.ViewMouseBinding)
+	GetDefaultKeybindings() []*Binding
 
 	// Returns true if we're running an integration test
 	RunningIntegrationTest() bool
@@ -151,6 +153,8 @@ type CreateMenuOptions struct {
 	Items           []*MenuItem
 	HideCancel      bool
 	ColumnAlignment []utils.Alignment
+	// called when the menu is closed without choosing an item
+	HandleClose func() error
 }
 
 type CreatePopupPanelOpts struct {
@@ -318,6 +322,7 @@ type IRepoStateAccessor interface {
 	GetSearchState() *SearchState
 	SetSplitMainPanel(bool)
 	GetSplitMainPanel() bool
+	GetJournal() *journal.Journal
 }
 
 // startup stages so we don't need to load everything at once
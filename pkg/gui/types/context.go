@@ -179,6 +179,10 @@ type IViewTrait interface {
 type OnFocusOpts struct {
 	ClickedWindowName  string
 	ClickedViewLineIdx int
+	ClickedViewColumn  int
+	// if the clicked diff was shown side by side, the width it was laid out
+	// for, since a row can then stand for both a removed and an added line
+	ClickedSideBySideWidth int
 }
 
 type OnFocusLostOpts struct {
@@ -32,6 +32,12 @@ type Binding struct {
 	// invoke it. When left nil, the command is always enabled. Note that this
 	// function must not do expensive calls.
 	GetDisabledReason func() string
+
+	// Optional function returning the items of the menu that the handler
+	// opens, without opening it. Used by the command palette to offer the menu
+	// items directly. Like GetDisabledReason, it must not have side effects or
+	// do expensive calls. Only makes sense together with OpensMenu.
+	GetMenuItems func() []*MenuItem
 }
 
 // A guard is a decorator which checks something before executing a handler
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/jesseduffield/gocui"
+
 // models/views that we can refresh
 type RefreshableView int
 
@@ -36,4 +38,6 @@ type RefreshOptions struct {
 	Then  func()
 	Scope []RefreshableView // e.g. []RefreshableView{COMMITS, BRANCHES}. Leave empty to refresh everything
 	Mode  RefreshMode       // one of SYNC (default), ASYNC, and BLOCK_UI
+	// If set, loading commits is terminated when the task is cancelled
+	Task gocui.Task
 }
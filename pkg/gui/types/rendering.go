@@ -69,6 +69,18 @@ func NewRenderStringWithScrollTask(str string, originX int, originY int) *Render
 	return &RenderStringWithScrollTask{Str: str, OriginX: originX, OriginY: originY}
 }
 
+// Works out in the background which task to run, for when that takes running
+// git commands
+type DeferredTask struct {
+	GetTask func() UpdateTask
+}
+
+func (t *DeferredTask) IsUpdateTask() {}
+
+func NewDeferredTask(getTask func() UpdateTask) *DeferredTask {
+	return &DeferredTask{GetTask: getTask}
+}
+
 type RunCommandTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
@@ -87,6 +99,8 @@ func NewRunCommandTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunCommandTask {
 type RunPtyTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// Whether the command outputs a diff, which can be shown side by side
+	IsDiff bool
 }
 
 func (t *RunPtyTask) IsUpdateTask() {}
@@ -94,3 +108,7 @@ func (t *RunPtyTask) IsUpdateTask() {}
 func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd}
 }
+
+func NewRunPtyDiffTask(cmd *exec.Cmd) *RunPtyTask {
+	return &RunPtyTask{Cmd: cmd, IsDiff: true}
+}
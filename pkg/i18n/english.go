@@ -510,128 +510,350 @@ type TranslationSet struct {
 	NavigationTitle                     string
 	SuggestionsCheatsheetTitle          string
 	// Unlike the cheatsheet title above, the real suggestions title has a little message saying press tab to focus
-	SuggestionsTitle                    string
-	ExtrasTitle                         string
-	PushingTagStatus                    string
-	PullRequestURLCopiedToClipboard     string
-	CommitDiffCopiedToClipboard         string
-	CommitSHACopiedToClipboard          string
-	CommitURLCopiedToClipboard          string
-	CommitMessageCopiedToClipboard      string
-	CommitAuthorCopiedToClipboard       string
-	PatchCopiedToClipboard              string
-	CopiedToClipboard                   string
-	ErrCannotEditDirectory              string
-	ErrStageDirWithInlineMergeConflicts string
-	ErrRepositoryMovedOrDeleted         string
-	ErrWorktreeMovedOrRemoved           string
-	CommandLog                          string
-	ToggleShowCommandLog                string
-	FocusCommandLog                     string
-	CommandLogHeader                    string
-	RandomTip                           string
-	SelectParentCommitForMerge          string
-	ToggleWhitespaceInDiffView          string
-	IgnoreWhitespaceDiffViewSubTitle    string
-	IgnoreWhitespaceNotSupportedHere    string
-	IncreaseContextInDiffView           string
-	DecreaseContextInDiffView           string
-	DiffContextSizeChanged              string
-	CreatePullRequestOptions            string
-	DefaultBranch                       string
-	SelectBranch                        string
-	CreatePullRequest                   string
-	SelectConfigFile                    string
-	NoConfigFileFoundErr                string
-	LoadingFileSuggestions              string
-	LoadingCommits                      string
-	MustSpecifyOriginError              string
-	GitOutput                           string
-	GitCommandFailed                    string
-	AbortTitle                          string
-	AbortPrompt                         string
-	OpenLogMenu                         string
-	LogMenuTitle                        string
-	ToggleShowGitGraphAll               string
-	ShowGitGraph                        string
-	SortCommits                         string
-	CantChangeContextSizeError          string
-	OpenCommitInBrowser                 string
-	ViewBisectOptions                   string
-	ConfirmRevertCommit                 string
-	RewordInEditorTitle                 string
-	RewordInEditorPrompt                string
-	CheckoutPrompt                      string
-	HardResetAutostashPrompt            string
-	UpstreamGone                        string
-	NukeDescription                     string
-	DiscardStagedChangesDescription     string
-	EmptyOutput                         string
-	Patch                               string
-	CustomPatch                         string
-	CommitsCopied                       string
-	CommitCopied                        string
-	ResetPatch                          string
-	ApplyPatch                          string
-	ApplyPatchInReverse                 string
-	RemovePatchFromOriginalCommit       string
-	MovePatchOutIntoIndex               string
-	MovePatchIntoNewCommit              string
-	MovePatchToSelectedCommit           string
-	CopyPatchToClipboard                string
-	NoMatchesFor                        string
-	MatchesFor                          string
-	SearchKeybindings                   string
-	SearchPrefix                        string
-	FilterPrefix                        string
-	ExitSearchMode                      string
-	ExitTextFilterMode                  string
-	SwitchToWorktree                    string
-	AlreadyCheckedOutByWorktree         string
-	BranchCheckedOutByWorktree          string
-	DetachWorktreeTooltip               string
-	Switching                           string
-	RemoveWorktree                      string
-	RemoveWorktreeTitle                 string
-	DetachWorktree                      string
-	DetachingWorktree                   string
-	WorktreesTitle                      string
-	WorktreeTitle                       string
-	RemoveWorktreePrompt                string
-	ForceRemoveWorktreePrompt           string
-	RemovingWorktree                    string
-	AddingWorktree                      string
-	CantDeleteCurrentWorktree           string
-	AlreadyInWorktree                   string
-	CantDeleteMainWorktree              string
-	NoWorktreesThisRepo                 string
-	MissingWorktree                     string
-	MainWorktree                        string
-	CreateWorktree                      string
-	NewWorktreePath                     string
-	NewWorktreeBase                     string
-	BranchNameCannotBeBlank             string
-	NewBranchName                       string
-	NewBranchNameLeaveBlank             string
-	ViewWorktreeOptions                 string
-	CreateWorktreeFrom                  string
-	CreateWorktreeFromDetached          string
-	LcWorktree                          string
-	ChangingDirectoryTo                 string
-	Name                                string
-	Branch                              string
-	Path                                string
-	MarkedBaseCommitStatus              string
-	MarkAsBaseCommit                    string
-	MarkAsBaseCommitTooltip             string
-	MarkedCommitMarker                  string
-	PleaseGoToURL                       string
-	DisabledMenuItemPrefix              string
-	NoCommitSelected                    string
-	NoCopiedCommits                     string
-	Actions                             Actions
-	Bisect                              Bisect
-	Log                                 Log
+	SuggestionsTitle                     string
+	ExtrasTitle                          string
+	PushingTagStatus                     string
+	PullRequestURLCopiedToClipboard      string
+	CommitDiffCopiedToClipboard          string
+	CommitSHACopiedToClipboard           string
+	CommitURLCopiedToClipboard           string
+	CommitMessageCopiedToClipboard       string
+	CommitAuthorCopiedToClipboard        string
+	PatchCopiedToClipboard               string
+	CopiedToClipboard                    string
+	ErrCannotEditDirectory               string
+	ErrStageDirWithInlineMergeConflicts  string
+	ErrRepositoryMovedOrDeleted          string
+	ErrWorktreeMovedOrRemoved            string
+	CommandLog                           string
+	ToggleShowCommandLog                 string
+	FocusCommandLog                      string
+	CommandLogHeader                     string
+	RandomTip                            string
+	SelectParentCommitForMerge           string
+	ToggleWhitespaceInDiffView           string
+	IgnoreWhitespaceDiffViewSubTitle     string
+	IgnoreWhitespaceNotSupportedHere     string
+	IncreaseContextInDiffView            string
+	DecreaseContextInDiffView            string
+	DiffContextSizeChanged               string
+	CreatePullRequestOptions             string
+	DefaultBranch                        string
+	SelectBranch                         string
+	CreatePullRequest                    string
+	SelectConfigFile                     string
+	NoConfigFileFoundErr                 string
+	LoadingFileSuggestions               string
+	LoadingCommits                       string
+	MustSpecifyOriginError               string
+	GitOutput                            string
+	GitCommandFailed                     string
+	AbortTitle                           string
+	AbortPrompt                          string
+	OpenLogMenu                          string
+	LogMenuTitle                         string
+	ToggleShowGitGraphAll                string
+	ShowGitGraph                         string
+	SortCommits                          string
+	CantChangeContextSizeError           string
+	OpenCommitInBrowser                  string
+	ViewBisectOptions                    string
+	ConfirmRevertCommit                  string
+	RewordInEditorTitle                  string
+	RewordInEditorPrompt                 string
+	CheckoutPrompt                       string
+	HardResetAutostashPrompt             string
+	UpstreamGone                         string
+	NukeDescription                      string
+	DiscardStagedChangesDescription      string
+	EmptyOutput                          string
+	Patch                                string
+	CustomPatch                          string
+	CommitsCopied                        string
+	CommitCopied                         string
+	ResetPatch                           string
+	ApplyPatch                           string
+	ApplyPatchInReverse                  string
+	RemovePatchFromOriginalCommit        string
+	MovePatchOutIntoIndex                string
+	MovePatchIntoNewCommit               string
+	MovePatchToSelectedCommit            string
+	CopyPatchToClipboard                 string
+	NoMatchesFor                         string
+	MatchesFor                           string
+	SearchKeybindings                    string
+	SearchPrefix                         string
+	FilterPrefix                         string
+	ExitSearchMode                       string
+	ExitTextFilterMode                   string
+	SwitchToWorktree                     string
+	AlreadyCheckedOutByWorktree          string
+	BranchCheckedOutByWorktree           string
+	DetachWorktreeTooltip                string
+	Switching                            string
+	RemoveWorktree                       string
+	RemoveWorktreeTitle                  string
+	DetachWorktree                       string
+	DetachingWorktree                    string
+	WorktreesTitle                       string
+	WorktreeTitle                        string
+	RemoveWorktreePrompt                 string
+	ForceRemoveWorktreePrompt            string
+	RemovingWorktree                     string
+	AddingWorktree                       string
+	CantDeleteCurrentWorktree            string
+	AlreadyInWorktree                    string
+	CantDeleteMainWorktree               string
+	NoWorktreesThisRepo                  string
+	MissingWorktree                      string
+	MainWorktree                         string
+	CreateWorktree                       string
+	NewWorktreePath                      string
+	NewWorktreeBase                      string
+	BranchNameCannotBeBlank              string
+	NewBranchName                        string
+	NewBranchNameLeaveBlank              string
+	ViewWorktreeOptions                  string
+	CreateWorktreeFrom                   string
+	CreateWorktreeFromDetached           string
+	LcWorktree                           string
+	ChangingDirectoryTo                  string
+	Name                                 string
+	Branch                               string
+	Path                                 string
+	MarkedBaseCommitStatus               string
+	MarkAsBaseCommit                     string
+	MarkAsBaseCommitTooltip              string
+	MarkedCommitMarker                   string
+	PleaseGoToURL                        string
+	DisabledMenuItemPrefix               string
+	NoCommitSelected                     string
+	NoCopiedCommits                      string
+	ToggleAll                            string
+	NoItemsSelected                      string
+	ViewRemoteOptions                    string
+	RemoteOptionsTitle                   string
+	PruneRemote                          string
+	PruneRemoteTooltip                   string
+	PruneRemotePrompt                    string
+	NothingToPrune                       string
+	PruningStatus                        string
+	CleanUpMergedRemoteBranches          string
+	CleanUpMergedRemoteBranchesTooltip   string
+	NoMergedRemoteBranches               string
+	MergedRemoteBranchesTitle            string
+	DeleteSelectedBranches               string
+	DeleteRemoteBranchesPrompt           string
+	EditPushUrls                         string
+	EditPushUrlsTooltip                  string
+	PushUrlsTitle                        string
+	AddPushUrl                           string
+	NewPushUrl                           string
+	EditPushUrl                          string
+	EditFetchRefspecs                    string
+	EditFetchRefspecsTooltip             string
+	FetchRefspecsTitle                   string
+	AddFetchRefspec                      string
+	NewFetchRefspec                      string
+	EditFetchRefspec                     string
+	CleanUpBranches                      string
+	CleanUpBranchesTooltip               string
+	FindingBranchesToCleanUpStatus       string
+	NoBranchesToCleanUp                  string
+	BranchesToCleanUpTitle               string
+	CleanUpReasonMerged                  string
+	CleanUpReasonSquashMerged            string
+	CleanUpReasonUpstreamGone            string
+	CheckedOutInWorktree                 string
+	DeleteLocalBranchesPrompt            string
+	CreateSignedTag                      string
+	CreateSignedTagTooltip               string
+	CreatingSignedTagStatus              string
+	CompareTagsWithRemote                string
+	CompareTagsWithRemoteTooltip         string
+	SelectRemoteToCompareTags            string
+	ComparingTagsStatus                  string
+	TagsInSync                           string
+	TagSyncTitle                         string
+	TagLocalOnly                         string
+	TagRemoteOnly                        string
+	TagDiffers                           string
+	SyncSelectedTags                     string
+	PushTagsToRemote                     string
+	PushTagsToRemoteTooltip              string
+	FetchTagsFromRemote                  string
+	FetchTagsFromRemoteTooltip           string
+	DeleteTagsFromRemote                 string
+	DeleteTagsFromRemoteTooltip          string
+	DeleteTagsLocally                    string
+	DeleteTagsLocallyTooltip             string
+	NoApplicableTagsSelected             string
+	DeleteRemoteTagsPrompt               string
+	DeleteLocalTagsPrompt                string
+	TagDetailsTitle                      string
+	TaggerLabel                          string
+	TagDateLabel                         string
+	TagTargetLabel                       string
+	TagSignatureLabel                    string
+	TagNotSigned                         string
+	TagSigned                            string
+	RecoverLostCommits                   string
+	RecoverLostCommitsTooltip            string
+	DanglingCommits                      string
+	DanglingCommitsTooltip               string
+	BranchReflog                         string
+	BranchReflogTooltip                  string
+	BranchReflogTitle                    string
+	FindingDanglingCommits               string
+	NoDanglingCommits                    string
+	NoBranchReflogEntries                string
+	DroppedStash                         string
+	RecoverCommitTitle                   string
+	RestoreAsBranch                      string
+	RestoreAsStash                       string
+	RestoreAsStashTooltip                string
+	NotAStashCommit                      string
+	ContinueWithSelectedItems            string
+	MultilineInputBodyTitle              string
+	OpenPluginsMenu                      string
+	PluginsMenuTitle                     string
+	MoreKeybindingsStartingWith          string
+	KeybindingConflicts                  string
+	ViewKeybindingConflicts              string
+	KeybindingConflictsFound             string
+	KeybindingConflictShadows            string
+	OpenCommandPalette                   string
+	OpenCommandPaletteTooltip            string
+	CommandPaletteTitle                  string
+	SwitchTheme                          string
+	SwitchThemeTooltip                   string
+	ThemesMenuTitle                      string
+	CurrentTheme                         string
+	ViewAuditLog                         string
+	AuditLogTitle                        string
+	AuditLogIsEmpty                      string
+	SearchAllSessions                    string
+	AllSessions                          string
+	AuditLogEntryCount                   string
+	AuditLogEntryTooltip                 string
+	AuditLogCommandResult                string
+	CommandCopiedToClipboard             string
+	MustSpecifyRemoteForRefspecsError    string
+	ViewPushMenu                         string
+	ViewPushMenuTooltip                  string
+	PushMenuTitle                        string
+	NoRemotesToPushTo                    string
+	PushPreview                          string
+	PushPreviewTooltip                   string
+	PushPreviewTitle                     string
+	PreviewingPushStatus                 string
+	NothingToPush                        string
+	PushRemote                           string
+	PushRefspecs                         string
+	PushRefspecsTooltip                  string
+	PushRefspecsPrompt                   string
+	AddPushOption                        string
+	AddPushOptionTooltip                 string
+	PushOptionPrompt                     string
+	ClearPushOptions                     string
+	NoPushOptions                        string
+	AtomicPush                           string
+	AtomicPushTooltip                    string
+	ForceWithLease                       string
+	ForceWithLeaseTooltip                string
+	ForceIfIncludes                      string
+	ForceIfIncludesTooltip               string
+	ForceIfIncludesRequiresForce         string
+	ForceIfIncludesRequiresNewerGit      string
+	SetUpstreamWhenPushing               string
+	SetUpstreamWhenPushingTooltip        string
+	ViewPullMenu                         string
+	ViewPullMenuTooltip                  string
+	PullMenuTitle                        string
+	RememberedPullStrategy               string
+	PullMerge                            string
+	PullMergeTooltip                     string
+	PullRebase                           string
+	PullRebaseTooltip                    string
+	PullRebaseAutostash                  string
+	PullRebaseAutostashTooltip           string
+	PullFastForwardOnly                  string
+	PullFastForwardOnlyTooltip           string
+	PullGitConfigDefault                 string
+	PullGitConfigDefaultTooltip          string
+	IncomingCommits                      string
+	FetchSummaryTitle                    string
+	FetchNothingChanged                  string
+	FetchFailed                          string
+	CancelCommand                        string
+	CancelCommandTooltip                 string
+	CommandCancelled                     string
+	NoCommandToCancel                    string
+	UndoJournalEntryPrompt               string
+	RedoJournalEntryPrompt               string
+	RecoverDiscardedChanges              string
+	RecoverDiscardedChangesTooltip       string
+	NoDiscardedChanges                   string
+	RestoreAllFiles                      string
+	DeletedFile                          string
+	RestoringStatus                      string
+	CycleWordDiffInDiffView              string
+	CycleWordDiffInDiffViewTooltip       string
+	WordDiffWordsEnabled                 string
+	WordDiffCharsEnabled                 string
+	WordDiffDisabled                     string
+	OpenDiffOptionsMenu                  string
+	DiffOptionsMenuTitle                 string
+	DiffAlgorithm                        string
+	DiffAlgorithmTooltip                 string
+	ColorMovedLines                      string
+	ColorMovedLinesTooltip               string
+	RenameThreshold                      string
+	RenameThresholdTooltip               string
+	RenameThresholdPrompt                string
+	InvalidRenameThreshold               string
+	FindCopies                           string
+	FindCopiesTooltip                    string
+	GitDefault                           string
+	CantChangeDiffAlgorithmError         string
+	SideBySideDiff                       string
+	SideBySideDiffTooltip                string
+	ViewLfsOptions                       string
+	ViewLfsOptionsTooltip                string
+	LfsOptions                           string
+	LfsShowLocks                         string
+	LfsShowLocksTooltip                  string
+	LfsLocksTitle                        string
+	NoLfsLocks                           string
+	LfsLockFile                          string
+	LfsLockFileTooltip                   string
+	LfsLockFilePrompt                    string
+	LfsUnlock                            string
+	LfsForceUnlock                       string
+	LfsForceUnlockTooltip                string
+	LfsForceUnlockPrompt                 string
+	LfsFetch                             string
+	LfsFetchTooltip                      string
+	LfsPull                              string
+	LfsPullTooltip                       string
+	LfsPrune                             string
+	LfsPruneTooltip                      string
+	LfsPrunePrompt                       string
+	LoadingLocksStatus                   string
+	LockingStatus                        string
+	UnlockingStatus                      string
+	LfsObjectChanged                     string
+	LfsObjectAdded                       string
+	LfsObjectDeleted                     string
+	EditConfigValue                      string
+	RemoveConfigValue                    string
+	DeleteUnmergedBranchesWarning        string
+	CustomCommandRangeSelectNotSupported string
+	LoadingAuditLog                      string
+	DiscardingStatus                     string
+	Actions                              Actions
+	Bisect                               Bisect
+	Log                                  Log
 }
 
 type Bisect struct {
@@ -781,6 +1003,25 @@ type Actions struct {
 	BisectMark                        string
 	RemoveWorktree                    string
 	AddWorktree                       string
+	PruneRemote                       string
+	DeleteRemoteBranches              string
+	UpdateRemotePushUrl               string
+	UpdateRemoteFetchRefspec          string
+	DeleteLocalBranches               string
+	CreateSignedTag                   string
+	PushTags                          string
+	FetchTags                         string
+	DeleteRemoteTags                  string
+	DeleteLocalTags                   string
+	RestoreStash                      string
+	PluginCommand                     string
+	SetPullStrategy                   string
+	RecoverDiscardedChanges           string
+	LfsLock                           string
+	LfsUnlock                         string
+	LfsFetch                          string
+	LfsPull                           string
+	LfsPrune                          string
 }
 
 const englishIntroPopupMessage = `
@@ -934,8 +1175,8 @@ func EnglishTranslationSet() TranslationSet {
 		Undo:                                "Undo",
 		UndoReflog:                          "Undo",
 		RedoReflog:                          "Redo",
-		UndoTooltip:                         "The reflog will be used to determine what git command to run to undo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
-		RedoTooltip:                         "The reflog will be used to determine what git command to run to redo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
+		UndoTooltip:                         "The reflog will be used to determine what git command to run to undo the last git command. Deleting branches, tags and stash entries, discarding file changes and changing remote URLs are recorded separately and are undone in the same order. Other changes to the working tree aren't taken into consideration.",
+		RedoTooltip:                         "The reflog will be used to determine what git command to run to redo the last git command. Deleting branches, tags and stash entries, discarding file changes and changing remote URLs are recorded separately and are redone in the same order. Other changes to the working tree aren't taken into consideration.",
 		DiscardAllTooltip:                   "Discard both staged and unstaged changes in '{{.path}}'.",
 		DiscardUnstagedTooltip:              "Discard unstaged changes in '{{.path}}'.",
 		Pop:                                 "Pop",
@@ -1263,180 +1504,402 @@ func EnglishTranslationSet() TranslationSet {
 		SwapDiff:                         "Reverse diff direction",
 		OpenDiffingMenu:                  "Open diff menu",
 		// the actual view is the extras view which I intend to give more tabs in future but for now we'll only mention the command log part
-		OpenExtrasMenu:                      "Open command log menu",
-		ShowingGitDiff:                      "Showing output for:",
-		CommitDiff:                          "Commit diff",
-		CopyCommitShaToClipboard:            "Copy commit SHA to clipboard",
-		CommitSha:                           "Commit SHA",
-		CommitURL:                           "Commit URL",
-		CopyCommitMessageToClipboard:        "Copy commit message to clipboard",
-		CommitMessage:                       "Commit message",
-		CommitAuthor:                        "Commit author",
-		CopyCommitAttributeToClipboard:      "Copy commit attribute",
-		CopyBranchNameToClipboard:           "Copy branch name to clipboard",
-		CopyFileNameToClipboard:             "Copy the file name to the clipboard",
-		CopyCommitFileNameToClipboard:       "Copy the committed file name to the clipboard",
-		CopySelectedTexToClipboard:          "Copy the selected text to the clipboard",
-		CommitPrefixPatternError:            "Error in commitPrefix pattern",
-		NoFilesStagedTitle:                  "No files staged",
-		NoFilesStagedPrompt:                 "You have not staged any files. Commit all files?",
-		BranchNotFoundTitle:                 "Branch not found",
-		BranchNotFoundPrompt:                "Branch not found. Create a new branch named",
-		BranchUnknown:                       "Branch unknown",
-		DiscardChangeTitle:                  "Discard change",
-		DiscardChangePrompt:                 "Are you sure you want to discard this change (git reset)? It is irreversible.\nTo disable this dialogue set the config key of 'gui.skipDiscardChangeWarning' to true",
-		CreateNewBranchFromCommit:           "Create new branch off of commit",
-		BuildingPatch:                       "Building patch",
-		ViewCommits:                         "View commits",
-		MinGitVersionError:                  "Git version must be at least 2.20 (i.e. from 2018 onwards). Please upgrade your git version. Alternatively raise an issue at https://github.com/jesseduffield/lazygit/issues for lazygit to be more backwards compatible.",
-		RunningCustomCommandStatus:          "Running custom command",
-		SubmoduleStashAndReset:              "Stash uncommitted submodule changes and update",
-		AndResetSubmodules:                  "And reset submodules",
-		EnterSubmodule:                      "Enter submodule",
-		CopySubmoduleNameToClipboard:        "Copy submodule name to clipboard",
-		RemoveSubmodule:                     "Remove submodule",
-		RemoveSubmodulePrompt:               "Are you sure you want to remove submodule '%s' and its corresponding directory? This is irreversible.",
-		ResettingSubmoduleStatus:            "Resetting submodule",
-		NewSubmoduleName:                    "New submodule name:",
-		NewSubmoduleUrl:                     "New submodule URL:",
-		NewSubmodulePath:                    "New submodule path:",
-		AddSubmodule:                        "Add new submodule",
-		AddingSubmoduleStatus:               "Adding submodule",
-		UpdateSubmoduleUrl:                  "Update URL for submodule '%s'",
-		UpdatingSubmoduleUrlStatus:          "Updating URL",
-		EditSubmoduleUrl:                    "Update submodule URL",
-		InitializingSubmoduleStatus:         "Initializing submodule",
-		InitSubmodule:                       "Initialize submodule",
-		SubmoduleUpdate:                     "Update submodule",
-		UpdatingSubmoduleStatus:             "Updating submodule",
-		BulkInitSubmodules:                  "Bulk init submodules",
-		BulkUpdateSubmodules:                "Bulk update submodules",
-		BulkDeinitSubmodules:                "Bulk deinit submodules",
-		ViewBulkSubmoduleOptions:            "View bulk submodule options",
-		BulkSubmoduleOptions:                "Bulk submodule options",
-		RunningCommand:                      "Running command",
-		SubCommitsTitle:                     "Sub-commits",
-		SubmodulesTitle:                     "Submodules",
-		NavigationTitle:                     "List panel navigation",
-		SuggestionsCheatsheetTitle:          "Suggestions",
-		SuggestionsTitle:                    "Suggestions (press %s to focus)",
-		ExtrasTitle:                         "Command log",
-		PushingTagStatus:                    "Pushing tag",
-		PullRequestURLCopiedToClipboard:     "Pull request URL copied to clipboard",
-		CommitDiffCopiedToClipboard:         "Commit diff copied to clipboard",
-		CommitSHACopiedToClipboard:          "Commit SHA copied to clipboard",
-		CommitURLCopiedToClipboard:          "Commit URL copied to clipboard",
-		CommitMessageCopiedToClipboard:      "Commit message copied to clipboard",
-		CommitAuthorCopiedToClipboard:       "Commit author copied to clipboard",
-		PatchCopiedToClipboard:              "Patch copied to clipboard",
-		CopiedToClipboard:                   "Copied to clipboard",
-		ErrCannotEditDirectory:              "Cannot edit directory: you can only edit individual files",
-		ErrStageDirWithInlineMergeConflicts: "Cannot stage/unstage directory containing files with inline merge conflicts. Please fix up the merge conflicts first",
-		ErrRepositoryMovedOrDeleted:         "Cannot find repo. It might have been moved or deleted ¯\\_(ツ)_/¯",
-		CommandLog:                          "Command log",
-		ErrWorktreeMovedOrRemoved:           "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
-		ToggleShowCommandLog:                "Toggle show/hide command log",
-		FocusCommandLog:                     "Focus command log",
-		CommandLogHeader:                    "You can hide/focus this panel by pressing '%s'\n",
-		RandomTip:                           "Random tip",
-		SelectParentCommitForMerge:          "Select parent commit for merge",
-		ToggleWhitespaceInDiffView:          "Toggle whether or not whitespace changes are shown in the diff view",
-		IgnoreWhitespaceDiffViewSubTitle:    "(ignoring whitespace)",
-		IgnoreWhitespaceNotSupportedHere:    "Ignoring whitespace is not supported in this view",
-		IncreaseContextInDiffView:           "Increase the size of the context shown around changes in the diff view",
-		DecreaseContextInDiffView:           "Decrease the size of the context shown around changes in the diff view",
-		DiffContextSizeChanged:              "Changed diff context size to %d",
-		CreatePullRequestOptions:            "Create pull request options",
-		DefaultBranch:                       "Default branch",
-		SelectBranch:                        "Select branch",
-		SelectConfigFile:                    "Select config file",
-		NoConfigFileFoundErr:                "No config file found",
-		LoadingFileSuggestions:              "Loading file suggestions",
-		LoadingCommits:                      "Loading commits",
-		MustSpecifyOriginError:              "Must specify a remote if specifying a branch",
-		GitOutput:                           "Git output:",
-		GitCommandFailed:                    "Git command failed. Check command log for details (open with %s)",
-		AbortTitle:                          "Abort %s",
-		AbortPrompt:                         "Are you sure you want to abort the current %s?",
-		OpenLogMenu:                         "Open log menu",
-		LogMenuTitle:                        "Commit Log Options",
-		ToggleShowGitGraphAll:               "Toggle show whole git graph (pass the `--all` flag to `git log`)",
-		ShowGitGraph:                        "Show git graph",
-		SortCommits:                         "Commit sort order",
-		CantChangeContextSizeError:          "Cannot change context while in patch building mode because we were too lazy to support it when releasing the feature. If you really want it, please let us know!",
-		OpenCommitInBrowser:                 "Open commit in browser",
-		ViewBisectOptions:                   "View bisect options",
-		ConfirmRevertCommit:                 "Are you sure you want to revert {{.selectedCommit}}?",
-		RewordInEditorTitle:                 "Reword in editor",
-		RewordInEditorPrompt:                "Are you sure you want to reword this commit in your editor?",
-		HardResetAutostashPrompt:            "Are you sure you want to hard reset to '%s'? An auto-stash will be performed if necessary.",
-		CheckoutPrompt:                      "Are you sure you want to checkout '%s'?",
-		UpstreamGone:                        "(upstream gone)",
-		NukeDescription:                     "If you want to make all the changes in the worktree go away, this is the way to do it. If there are dirty submodule changes this will stash those changes in the submodule(s).",
-		DiscardStagedChangesDescription:     "This will create a new stash entry containing only staged files and then drop it, so that the working tree is left with only unstaged changes",
-		EmptyOutput:                         "<Empty output>",
-		Patch:                               "Patch",
-		CustomPatch:                         "Custom patch",
-		CommitsCopied:                       "commits copied", // lowercase because it's used in a sentence
-		CommitCopied:                        "commit copied",  // lowercase because it's used in a sentence
-		ResetPatch:                          "Reset patch",
-		ApplyPatch:                          "Apply patch",
-		ApplyPatchInReverse:                 "Apply patch in reverse",
-		RemovePatchFromOriginalCommit:       "Remove patch from original commit (%s)",
-		MovePatchOutIntoIndex:               "Move patch out into index",
-		MovePatchIntoNewCommit:              "Move patch into new commit",
-		MovePatchToSelectedCommit:           "Move patch to selected commit (%s)",
-		CopyPatchToClipboard:                "Copy patch to clipboard",
-		NoMatchesFor:                        "No matches for '%s' %s",
-		ExitSearchMode:                      "%s: Exit search mode",
-		ExitTextFilterMode:                  "%s: Exit filter mode",
-		MatchesFor:                          "matches for '%s' (%d of %d) %s", // lowercase because it's after other text
-		SearchKeybindings:                   "%s: Next match, %s: Previous match, %s: Exit search mode",
-		SearchPrefix:                        "Search: ",
-		FilterPrefix:                        "Filter: ",
-		WorktreesTitle:                      "Worktrees",
-		WorktreeTitle:                       "Worktree",
-		SwitchToWorktree:                    "Switch to worktree",
-		AlreadyCheckedOutByWorktree:         "This branch is checked out by worktree {{.worktreeName}}. Do you want to switch to that worktree?",
-		BranchCheckedOutByWorktree:          "Branch {{.branchName}} is checked out by worktree {{.worktreeName}}",
-		DetachWorktreeTooltip:               "This will run `git checkout --detach` on the worktree so that it stops hogging the branch, but the worktree's working tree will be left alone",
-		Switching:                           "Switching",
-		RemoveWorktree:                      "Remove worktree",
-		RemoveWorktreeTitle:                 "Remove worktree",
-		RemoveWorktreePrompt:                "Are you sure you want to remove worktree '{{.worktreeName}}'?",
-		ForceRemoveWorktreePrompt:           "'{{.worktreeName}}' contains modified or untracked files (to be honest, it could contain both). Are you sure you want to remove it?",
-		RemovingWorktree:                    "Deleting worktree",
-		DetachWorktree:                      "Detach worktree",
-		DetachingWorktree:                   "Detaching worktree",
-		AddingWorktree:                      "Adding worktree",
-		CantDeleteCurrentWorktree:           "You cannot remove the current worktree!",
-		AlreadyInWorktree:                   "You are already in the selected worktree",
-		CantDeleteMainWorktree:              "You cannot remove the main worktree!",
-		NoWorktreesThisRepo:                 "No worktrees",
-		MissingWorktree:                     "(missing)",
-		MainWorktree:                        "(main)",
-		CreateWorktree:                      "Create worktree",
-		NewWorktreePath:                     "New worktree path",
-		NewWorktreeBase:                     "New worktree base ref",
-		BranchNameCannotBeBlank:             "Branch name cannot be blank",
-		NewBranchName:                       "New branch name",
-		NewBranchNameLeaveBlank:             "New branch name (leave blank to checkout {{.default}})",
-		ViewWorktreeOptions:                 "View worktree options",
-		CreateWorktreeFrom:                  "Create worktree from {{.ref}}",
-		CreateWorktreeFromDetached:          "Create worktree from {{.ref}} (detached)",
-		LcWorktree:                          "worktree",
-		ChangingDirectoryTo:                 "Changing directory to {{.path}}",
-		Name:                                "Name",
-		Branch:                              "Branch",
-		Path:                                "Path",
-		MarkedBaseCommitStatus:              "Marked a base commit for rebase",
-		MarkAsBaseCommit:                    "Mark commit as base commit for rebase",
-		MarkAsBaseCommitTooltip:             "Select a base commit for the next rebase; this will effectively perform a 'git rebase --onto'.",
-		MarkedCommitMarker:                  "↑↑↑ Will rebase from here ↑↑↑",
-		PleaseGoToURL:                       "Please go to {{.url}}",
-		DisabledMenuItemPrefix:              "Disabled: ",
-		NoCommitSelected:                    "No commit selected",
-		NoCopiedCommits:                     "No copied commits",
+		OpenExtrasMenu:                       "Open command log menu",
+		ShowingGitDiff:                       "Showing output for:",
+		CommitDiff:                           "Commit diff",
+		CopyCommitShaToClipboard:             "Copy commit SHA to clipboard",
+		CommitSha:                            "Commit SHA",
+		CommitURL:                            "Commit URL",
+		CopyCommitMessageToClipboard:         "Copy commit message to clipboard",
+		CommitMessage:                        "Commit message",
+		CommitAuthor:                         "Commit author",
+		CopyCommitAttributeToClipboard:       "Copy commit attribute",
+		CopyBranchNameToClipboard:            "Copy branch name to clipboard",
+		CopyFileNameToClipboard:              "Copy the file name to the clipboard",
+		CopyCommitFileNameToClipboard:        "Copy the committed file name to the clipboard",
+		CopySelectedTexToClipboard:           "Copy the selected text to the clipboard",
+		CommitPrefixPatternError:             "Error in commitPrefix pattern",
+		NoFilesStagedTitle:                   "No files staged",
+		NoFilesStagedPrompt:                  "You have not staged any files. Commit all files?",
+		BranchNotFoundTitle:                  "Branch not found",
+		BranchNotFoundPrompt:                 "Branch not found. Create a new branch named",
+		BranchUnknown:                        "Branch unknown",
+		DiscardChangeTitle:                   "Discard change",
+		DiscardChangePrompt:                  "Are you sure you want to discard this change (git reset)? It is irreversible.\nTo disable this dialogue set the config key of 'gui.skipDiscardChangeWarning' to true",
+		CreateNewBranchFromCommit:            "Create new branch off of commit",
+		BuildingPatch:                        "Building patch",
+		ViewCommits:                          "View commits",
+		MinGitVersionError:                   "Git version must be at least 2.20 (i.e. from 2018 onwards). Please upgrade your git version. Alternatively raise an issue at https://github.com/jesseduffield/lazygit/issues for lazygit to be more backwards compatible.",
+		RunningCustomCommandStatus:           "Running custom command",
+		SubmoduleStashAndReset:               "Stash uncommitted submodule changes and update",
+		AndResetSubmodules:                   "And reset submodules",
+		EnterSubmodule:                       "Enter submodule",
+		CopySubmoduleNameToClipboard:         "Copy submodule name to clipboard",
+		RemoveSubmodule:                      "Remove submodule",
+		RemoveSubmodulePrompt:                "Are you sure you want to remove submodule '%s' and its corresponding directory? This is irreversible.",
+		ResettingSubmoduleStatus:             "Resetting submodule",
+		NewSubmoduleName:                     "New submodule name:",
+		NewSubmoduleUrl:                      "New submodule URL:",
+		NewSubmodulePath:                     "New submodule path:",
+		AddSubmodule:                         "Add new submodule",
+		AddingSubmoduleStatus:                "Adding submodule",
+		UpdateSubmoduleUrl:                   "Update URL for submodule '%s'",
+		UpdatingSubmoduleUrlStatus:           "Updating URL",
+		EditSubmoduleUrl:                     "Update submodule URL",
+		InitializingSubmoduleStatus:          "Initializing submodule",
+		InitSubmodule:                        "Initialize submodule",
+		SubmoduleUpdate:                      "Update submodule",
+		UpdatingSubmoduleStatus:              "Updating submodule",
+		BulkInitSubmodules:                   "Bulk init submodules",
+		BulkUpdateSubmodules:                 "Bulk update submodules",
+		BulkDeinitSubmodules:                 "Bulk deinit submodules",
+		ViewBulkSubmoduleOptions:             "View bulk submodule options",
+		BulkSubmoduleOptions:                 "Bulk submodule options",
+		RunningCommand:                       "Running command",
+		SubCommitsTitle:                      "Sub-commits",
+		SubmodulesTitle:                      "Submodules",
+		NavigationTitle:                      "List panel navigation",
+		SuggestionsCheatsheetTitle:           "Suggestions",
+		SuggestionsTitle:                     "Suggestions (press %s to focus)",
+		ExtrasTitle:                          "Command log",
+		PushingTagStatus:                     "Pushing tag",
+		PullRequestURLCopiedToClipboard:      "Pull request URL copied to clipboard",
+		CommitDiffCopiedToClipboard:          "Commit diff copied to clipboard",
+		CommitSHACopiedToClipboard:           "Commit SHA copied to clipboard",
+		CommitURLCopiedToClipboard:           "Commit URL copied to clipboard",
+		CommitMessageCopiedToClipboard:       "Commit message copied to clipboard",
+		CommitAuthorCopiedToClipboard:        "Commit author copied to clipboard",
+		PatchCopiedToClipboard:               "Patch copied to clipboard",
+		CopiedToClipboard:                    "Copied to clipboard",
+		ErrCannotEditDirectory:               "Cannot edit directory: you can only edit individual files",
+		ErrStageDirWithInlineMergeConflicts:  "Cannot stage/unstage directory containing files with inline merge conflicts. Please fix up the merge conflicts first",
+		ErrRepositoryMovedOrDeleted:          "Cannot find repo. It might have been moved or deleted ¯\\_(ツ)_/¯",
+		CommandLog:                           "Command log",
+		ErrWorktreeMovedOrRemoved:            "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
+		ToggleShowCommandLog:                 "Toggle show/hide command log",
+		FocusCommandLog:                      "Focus command log",
+		CommandLogHeader:                     "You can hide/focus this panel by pressing '%s'\n",
+		RandomTip:                            "Random tip",
+		SelectParentCommitForMerge:           "Select parent commit for merge",
+		ToggleWhitespaceInDiffView:           "Toggle whether or not whitespace changes are shown in the diff view",
+		IgnoreWhitespaceDiffViewSubTitle:     "(ignoring whitespace)",
+		IgnoreWhitespaceNotSupportedHere:     "Ignoring whitespace is not supported in this view",
+		IncreaseContextInDiffView:            "Increase the size of the context shown around changes in the diff view",
+		DecreaseContextInDiffView:            "Decrease the size of the context shown around changes in the diff view",
+		DiffContextSizeChanged:               "Changed diff context size to %d",
+		CreatePullRequestOptions:             "Create pull request options",
+		DefaultBranch:                        "Default branch",
+		SelectBranch:                         "Select branch",
+		SelectConfigFile:                     "Select config file",
+		NoConfigFileFoundErr:                 "No config file found",
+		LoadingFileSuggestions:               "Loading file suggestions",
+		LoadingCommits:                       "Loading commits",
+		MustSpecifyOriginError:               "Must specify a remote if specifying a branch",
+		GitOutput:                            "Git output:",
+		GitCommandFailed:                     "Git command failed. Check command log for details (open with %s)",
+		AbortTitle:                           "Abort %s",
+		AbortPrompt:                          "Are you sure you want to abort the current %s?",
+		OpenLogMenu:                          "Open log menu",
+		LogMenuTitle:                         "Commit Log Options",
+		ToggleShowGitGraphAll:                "Toggle show whole git graph (pass the `--all` flag to `git log`)",
+		ShowGitGraph:                         "Show git graph",
+		SortCommits:                          "Commit sort order",
+		CantChangeContextSizeError:           "Cannot change context while in patch building mode because we were too lazy to support it when releasing the feature. If you really want it, please let us know!",
+		OpenCommitInBrowser:                  "Open commit in browser",
+		ViewBisectOptions:                    "View bisect options",
+		ConfirmRevertCommit:                  "Are you sure you want to revert {{.selectedCommit}}?",
+		RewordInEditorTitle:                  "Reword in editor",
+		RewordInEditorPrompt:                 "Are you sure you want to reword this commit in your editor?",
+		HardResetAutostashPrompt:             "Are you sure you want to hard reset to '%s'? An auto-stash will be performed if necessary.",
+		CheckoutPrompt:                       "Are you sure you want to checkout '%s'?",
+		UpstreamGone:                         "(upstream gone)",
+		NukeDescription:                      "If you want to make all the changes in the worktree go away, this is the way to do it. If there are dirty submodule changes this will stash those changes in the submodule(s).",
+		DiscardStagedChangesDescription:      "This will create a new stash entry containing only staged files and then drop it, so that the working tree is left with only unstaged changes",
+		EmptyOutput:                          "<Empty output>",
+		Patch:                                "Patch",
+		CustomPatch:                          "Custom patch",
+		CommitsCopied:                        "commits copied", // lowercase because it's used in a sentence
+		CommitCopied:                         "commit copied",  // lowercase because it's used in a sentence
+		ResetPatch:                           "Reset patch",
+		ApplyPatch:                           "Apply patch",
+		ApplyPatchInReverse:                  "Apply patch in reverse",
+		RemovePatchFromOriginalCommit:        "Remove patch from original commit (%s)",
+		MovePatchOutIntoIndex:                "Move patch out into index",
+		MovePatchIntoNewCommit:               "Move patch into new commit",
+		MovePatchToSelectedCommit:            "Move patch to selected commit (%s)",
+		CopyPatchToClipboard:                 "Copy patch to clipboard",
+		NoMatchesFor:                         "No matches for '%s' %s",
+		ExitSearchMode:                       "%s: Exit search mode",
+		ExitTextFilterMode:                   "%s: Exit filter mode",
+		MatchesFor:                           "matches for '%s' (%d of %d) %s", // lowercase because it's after other text
+		SearchKeybindings:                    "%s: Next match, %s: Previous match, %s: Exit search mode",
+		SearchPrefix:                         "Search: ",
+		FilterPrefix:                         "Filter: ",
+		WorktreesTitle:                       "Worktrees",
+		WorktreeTitle:                        "Worktree",
+		SwitchToWorktree:                     "Switch to worktree",
+		AlreadyCheckedOutByWorktree:          "This branch is checked out by worktree {{.worktreeName}}. Do you want to switch to that worktree?",
+		BranchCheckedOutByWorktree:           "Branch {{.branchName}} is checked out by worktree {{.worktreeName}}",
+		DetachWorktreeTooltip:                "This will run `git checkout --detach` on the worktree so that it stops hogging the branch, but the worktree's working tree will be left alone",
+		Switching:                            "Switching",
+		RemoveWorktree:                       "Remove worktree",
+		RemoveWorktreeTitle:                  "Remove worktree",
+		RemoveWorktreePrompt:                 "Are you sure you want to remove worktree '{{.worktreeName}}'?",
+		ForceRemoveWorktreePrompt:            "'{{.worktreeName}}' contains modified or untracked files (to be honest, it could contain both). Are you sure you want to remove it?",
+		RemovingWorktree:                     "Deleting worktree",
+		DetachWorktree:                       "Detach worktree",
+		DetachingWorktree:                    "Detaching worktree",
+		AddingWorktree:                       "Adding worktree",
+		CantDeleteCurrentWorktree:            "You cannot remove the current worktree!",
+		AlreadyInWorktree:                    "You are already in the selected worktree",
+		CantDeleteMainWorktree:               "You cannot remove the main worktree!",
+		NoWorktreesThisRepo:                  "No worktrees",
+		MissingWorktree:                      "(missing)",
+		MainWorktree:                         "(main)",
+		CreateWorktree:                       "Create worktree",
+		NewWorktreePath:                      "New worktree path",
+		NewWorktreeBase:                      "New worktree base ref",
+		BranchNameCannotBeBlank:              "Branch name cannot be blank",
+		NewBranchName:                        "New branch name",
+		NewBranchNameLeaveBlank:              "New branch name (leave blank to checkout {{.default}})",
+		ViewWorktreeOptions:                  "View worktree options",
+		CreateWorktreeFrom:                   "Create worktree from {{.ref}}",
+		CreateWorktreeFromDetached:           "Create worktree from {{.ref}} (detached)",
+		LcWorktree:                           "worktree",
+		ChangingDirectoryTo:                  "Changing directory to {{.path}}",
+		Name:                                 "Name",
+		Branch:                               "Branch",
+		Path:                                 "Path",
+		MarkedBaseCommitStatus:               "Marked a base commit for rebase",
+		MarkAsBaseCommit:                     "Mark commit as base commit for rebase",
+		MarkAsBaseCommitTooltip:              "Select a base commit for the next rebase; this will effectively perform a 'git rebase --onto'.",
+		MarkedCommitMarker:                   "↑↑↑ Will rebase from here ↑↑↑",
+		PleaseGoToURL:                        "Please go to {{.url}}",
+		DisabledMenuItemPrefix:               "Disabled: ",
+		NoCommitSelected:                     "No commit selected",
+		NoCopiedCommits:                      "No copied commits",
+		ToggleAll:                            "Toggle all",
+		NoItemsSelected:                      "No items selected",
+		ViewRemoteOptions:                    "View remote options",
+		RemoteOptionsTitle:                   "Remote '{{.remoteName}}'",
+		PruneRemote:                          "Prune stale remote branches",
+		PruneRemoteTooltip:                   "Fetch from the remote and delete remote-tracking branches which no longer exist on it ('git fetch --prune'). You'll see which branches will disappear before anything is deleted.",
+		PruneRemotePrompt:                    "The following remote-tracking branches no longer exist on '{{.remoteName}}' and will be deleted:\n\n{{.branches}}\n\nAre you sure you want to prune them?",
+		NothingToPrune:                       "No stale remote-tracking branches for '{{.remoteName}}'",
+		PruningStatus:                        "Pruning",
+		CleanUpMergedRemoteBranches:          "Clean up merged remote branches",
+		CleanUpMergedRemoteBranchesTooltip:   "List the branches on this remote which are fully merged into one of the main branches ({{.mainBranches}}) and delete the selected ones from the remote.",
+		NoMergedRemoteBranches:               "No branches on '{{.remoteName}}' are merged into a main branch",
+		MergedRemoteBranchesTitle:            "Branches on '{{.remoteName}}' merged into a main branch",
+		DeleteSelectedBranches:               "Delete selected branches",
+		DeleteRemoteBranchesPrompt:           "Are you sure you want to delete the following branches from '{{.remoteName}}'?\n\n{{.branches}}",
+		EditPushUrls:                         "Edit push URLs",
+		EditPushUrlsTooltip:                  "Push URLs override the remote's URL when pushing. If several are configured, git pushes to all of them.",
+		PushUrlsTitle:                        "Push URLs of '{{.remoteName}}'",
+		AddPushUrl:                           "Add push URL",
+		NewPushUrl:                           "New push URL:",
+		EditPushUrl:                          "Enter updated push URL:",
+		EditFetchRefspecs:                    "Edit fetch refspecs",
+		EditFetchRefspecsTooltip:             "Refspecs determine which refs are fetched from the remote and where they are stored locally, e.g. '+refs/heads/*:refs/remotes/origin/*'",
+		FetchRefspecsTitle:                   "Fetch refspecs of '{{.remoteName}}'",
+		AddFetchRefspec:                      "Add fetch refspec",
+		NewFetchRefspec:                      "New fetch refspec:",
+		EditFetchRefspec:                     "Enter updated fetch refspec:",
+		CleanUpBranches:                      "Clean up branches",
+		CleanUpBranchesTooltip:               "List the local branches whose upstream is gone or which are merged into one of the main branches ({{.mainBranches}}), including squash-merged ones, and delete the selected ones.",
+		FindingBranchesToCleanUpStatus:       "Finding branches to clean up",
+		NoBranchesToCleanUp:                  "There are no local branches which are merged into a main branch or whose upstream is gone",
+		BranchesToCleanUpTitle:               "Branches to clean up",
+		CleanUpReasonMerged:                  "merged into {{.ref}}",
+		CleanUpReasonSquashMerged:            "squash-merged into {{.ref}}",
+		CleanUpReasonUpstreamGone:            "upstream gone",
+		CheckedOutInWorktree:                 "checked out in worktree {{.worktreeName}}",
+		DeleteLocalBranchesPrompt:            "Are you sure you want to delete the following local branches?\n\n{{.branches}}",
+		CreateSignedTag:                      "Create signed tag",
+		CreateSignedTagTooltip:               "Create a new annotated tag signed with your GPG or SSH key, as configured via git's user.signingKey and gpg.format settings.",
+		CreatingSignedTagStatus:              "Creating signed tag",
+		CompareTagsWithRemote:                "Compare tags with remote",
+		CompareTagsWithRemoteTooltip:         "List the tags that only exist locally, only exist on a remote, or point to different objects, and push, fetch or delete them in bulk to bring the two in sync.",
+		SelectRemoteToCompareTags:            "Remote to compare tags with:",
+		ComparingTagsStatus:                  "Comparing tags",
+		TagsInSync:                           "Local and remote tags are in sync",
+		TagSyncTitle:                         "Tags out of sync with '{{.remoteName}}'",
+		TagLocalOnly:                         "local only",
+		TagRemoteOnly:                        "remote only",
+		TagDiffers:                           "differs",
+		SyncSelectedTags:                     "Sync selected tags",
+		PushTagsToRemote:                     "Push to '{{.remoteName}}'",
+		PushTagsToRemoteTooltip:              "Push the selected tags that are local only or differ. Differing tags on the remote are overwritten.",
+		FetchTagsFromRemote:                  "Fetch from '{{.remoteName}}'",
+		FetchTagsFromRemoteTooltip:           "Fetch the selected tags that are remote only or differ. Differing local tags are overwritten.",
+		DeleteTagsFromRemote:                 "Delete from '{{.remoteName}}'",
+		DeleteTagsFromRemoteTooltip:          "Delete the selected tags that are remote only or differ from the remote.",
+		DeleteTagsLocally:                    "Delete locally",
+		DeleteTagsLocallyTooltip:             "Delete the selected tags that are local only or differ from the local repo.",
+		NoApplicableTagsSelected:             "None of the selected tags apply to this action",
+		DeleteRemoteTagsPrompt:               "Are you sure you want to delete the following tags from '{{.remoteName}}'?\n\n{{.tags}}",
+		DeleteLocalTagsPrompt:                "Are you sure you want to delete the following local tags?\n\n{{.tags}}",
+		TagDetailsTitle:                      "Tag details",
+		TaggerLabel:                          "Tagger",
+		TagDateLabel:                         "Date",
+		TagTargetLabel:                       "Target",
+		TagSignatureLabel:                    "Signature",
+		TagNotSigned:                         "not signed",
+		TagSigned:                            "signed",
+		RecoverLostCommits:                   "Recover lost commits",
+		RecoverLostCommitsTooltip:            "Find commits that are no longer reachable from HEAD, such as commits that were reset away or stashes that were dropped, and restore them.",
+		DanglingCommits:                      "Dangling commits and dropped stashes",
+		DanglingCommitsTooltip:               "Show commits that are not reachable from any ref, as reported by 'git fsck --unreachable'. This includes stashes that have been dropped or cleared.",
+		BranchReflog:                         "Reflog of branch",
+		BranchReflogTooltip:                  "Show every commit a local branch has pointed to, as reported by 'git reflog show <branch>'. Unlike the HEAD reflog this also covers branches that were changed without being checked out.",
+		BranchReflogTitle:                    "Reflog of '{{.branchName}}'",
+		FindingDanglingCommits:               "Finding dangling commits",
+		NoDanglingCommits:                    "No dangling commits found",
+		NoBranchReflogEntries:                "Branch '{{.branchName}}' has no reflog entries",
+		DroppedStash:                         "stash",
+		RecoverCommitTitle:                   "Recover {{.sha}}",
+		RestoreAsBranch:                      "Restore as new branch",
+		RestoreAsStash:                       "Restore as stash entry",
+		RestoreAsStashTooltip:                "Store this commit in the stash again using 'git stash store', so that it can be applied or popped as usual.",
+		NotAStashCommit:                      "This commit was not created by git stash",
+		ContinueWithSelectedItems:            "Continue with selected",
+		MultilineInputBodyTitle:              "Body",
+		OpenPluginsMenu:                      "Open plugins menu",
+		PluginsMenuTitle:                     "Plugins",
+		MoreKeybindingsStartingWith:          "Keybindings starting with '{{.keys}}'",
+		KeybindingConflicts:                  "Keybinding conflicts",
+		ViewKeybindingConflicts:              "View keybinding conflicts ({{.count}})",
+		KeybindingConflictsFound:             "Found {{.count}} conflicting keybindings. See the keybindings menu ({{.key}}) for details",
+		KeybindingConflictShadows:            "shadows {{.bindings}}",
+		OpenCommandPalette:                   "Open command palette",
+		OpenCommandPaletteTooltip:            "Search all actions of all panels, including custom commands and the items of some menus (e.g. the reset options), and run the selected one after switching to its panel.",
+		CommandPaletteTitle:                  "Command palette",
+		SwitchTheme:                          "Switch theme",
+		SwitchThemeTooltip:                   "Pick one of the bundled themes or a theme file from the 'themes' folder of the config directory. The choice is remembered across sessions.",
+		ThemesMenuTitle:                      "Themes",
+		CurrentTheme:                         "(current)",
+		ViewAuditLog:                         "View audit log",
+		AuditLogTitle:                        "Audit log",
+		AuditLogIsEmpty:                      "The audit log is empty. Actions and commands are recorded in it while auditLog.enabled is set in your config.",
+		SearchAllSessions:                    "Search all sessions",
+		AllSessions:                          "All sessions",
+		AuditLogEntryCount:                   "%d entries",
+		AuditLogEntryTooltip:                 "Repo: %s\n\nPress enter on a command to copy it to the clipboard.",
+		AuditLogCommandResult:                "exit %d, %s",
+		CommandCopiedToClipboard:             "Command copied to clipboard",
+		MustSpecifyRemoteForRefspecsError:    "Must specify a remote if specifying refspecs",
+		ViewPushMenu:                         "View push options",
+		ViewPushMenuTooltip:                  "Push the current branch to a different remote or branch, push other refs, or preview what a push would change.",
+		PushMenuTitle:                        "Push",
+		NoRemotesToPushTo:                    "There are no remotes to push to",
+		PushPreview:                          "Preview (dry run)",
+		PushPreviewTooltip:                   "Show the refs that the push would update on the remote, without updating them.",
+		PushPreviewTitle:                     "Push preview",
+		PreviewingPushStatus:                 "Previewing push",
+		NothingToPush:                        "Everything up-to-date",
+		PushRemote:                           "Remote",
+		PushRefspecs:                         "Refs to push",
+		PushRefspecsTooltip:                  "Space-separated refspecs to push instead of the current branch, e.g. 'feature:review' to push to a branch with a different name, or 'main v1.0' to push a branch and a tag. This doesn't change the upstream unless 'Set upstream' is checked.",
+		PushRefspecsPrompt:                   "Refs to push (e.g. 'feature:review v1.0')",
+		AddPushOption:                        "Add push option",
+		AddPushOptionTooltip:                 "Pass a value to the server with --push-option, e.g. 'ci.skip' or 'merge_request.create' for GitLab.",
+		PushOptionPrompt:                     "Push option",
+		ClearPushOptions:                     "Clear push options",
+		NoPushOptions:                        "No push options have been added",
+		AtomicPush:                           "Atomic",
+		AtomicPushTooltip:                    "Update either all of the refs on the remote or none of them (--atomic).",
+		ForceWithLease:                       "Force with lease",
+		ForceWithLeaseTooltip:                "Overwrite the remote ref, unless it has changed since you last fetched it (--force-with-lease).",
+		ForceIfIncludes:                      "Force if includes",
+		ForceIfIncludesTooltip:               "Only force push if the remote changes have been integrated into your local branch (--force-if-includes).",
+		ForceIfIncludesRequiresForce:         "Only available when force pushing",
+		ForceIfIncludesRequiresNewerGit:      "Requires git 2.30 or newer",
+		SetUpstreamWhenPushing:               "Set upstream",
+		SetUpstreamWhenPushingTooltip:        "Make the pushed branch the upstream of the current branch (--set-upstream).",
+		ViewPullMenu:                         "View pull options",
+		ViewPullMenuTooltip:                  "Choose how to pull the current branch: merge, rebase, rebase with autostash or fast-forward only. The choice is remembered for the branch and used when pulling it later.",
+		PullMenuTitle:                        "Pull '%s'",
+		RememberedPullStrategy:               "(current)",
+		PullMerge:                            "Merge",
+		PullMergeTooltip:                     "Merge the upstream changes into the branch (git pull --no-rebase).",
+		PullRebase:                           "Rebase",
+		PullRebaseTooltip:                    "Rebase the branch onto the upstream changes (git pull --rebase).",
+		PullRebaseAutostash:                  "Rebase with autostash",
+		PullRebaseAutostashTooltip:           "Stash your local changes, rebase the branch onto the upstream changes and apply the stash again (git pull --rebase --autostash).",
+		PullFastForwardOnly:                  "Fast-forward only",
+		PullFastForwardOnlyTooltip:           "Only pull if the branch can be fast-forwarded to its upstream (git pull --ff-only).",
+		PullGitConfigDefault:                 "Git config default",
+		PullGitConfigDefaultTooltip:          "Forget the strategy chosen for this branch and pull according to your git config, e.g. pull.rebase.",
+		IncomingCommits:                      "Incoming commits (%d)",
+		FetchSummaryTitle:                    "Fetch summary",
+		FetchNothingChanged:                  "Already up to date",
+		FetchFailed:                          "Fetch failed",
+		CancelCommand:                        "Cancel running command",
+		CancelCommandTooltip:                 "Terminate the fetch, push, pull, rebase or commit loading that is running, e.g. because it's waiting for a server that doesn't respond or an exec line of a rebase hangs. The repo is refreshed afterwards.",
+		CommandCancelled:                     "Cancelled '{{.command}}'",
+		NoCommandToCancel:                    "No command is running",
+		UndoJournalEntryPrompt:               "Are you sure you want to undo '{{.operation}}'?",
+		RedoJournalEntryPrompt:               "Are you sure you want to redo '{{.operation}}'?",
+		RecoverDiscardedChanges:              "Recover discarded changes",
+		RecoverDiscardedChangesTooltip:       "Before discarding changes, lazygit stores the content of the affected files, including untracked ones, in a commit that isn't referenced by any ref. Pick one of these snapshots to restore some or all of its files in the working tree.",
+		NoDiscardedChanges:                   "There are no discarded changes to recover in this repo",
+		RestoreAllFiles:                      "Restore all files",
+		DeletedFile:                          "deleted",
+		RestoringStatus:                      "Restoring",
+		CycleWordDiffInDiffView:              "Cycle highlighting of changed words/characters in the diff view",
+		CycleWordDiffInDiffViewTooltip:       "Highlight the words that changed within lines of diffs, then the characters, then turn highlighting off again.",
+		WordDiffWordsEnabled:                 "Highlighting changed words",
+		WordDiffCharsEnabled:                 "Highlighting changed characters",
+		WordDiffDisabled:                     "Not highlighting changes within lines",
+		OpenDiffOptionsMenu:                  "Open diff options menu",
+		DiffOptionsMenuTitle:                 "Diff options",
+		DiffAlgorithm:                        "Diff algorithm",
+		DiffAlgorithmTooltip:                 "The algorithm git uses to find the changes between two versions of a file. 'histogram' and 'patience' often give more readable diffs of code than git's default.",
+		ColorMovedLines:                      "Colour moved lines",
+		ColorMovedLinesTooltip:               "Colour lines that were moved rather than added or removed differently (--color-moved).",
+		RenameThreshold:                      "Rename detection threshold",
+		RenameThresholdTooltip:               "How similar a removed and an added file need to be to count as a rename (--find-renames). Applies to the diffs of whole commits, stashes and ranges; the diffs of single files never detect renames, so that they match the file lists.",
+		RenameThresholdPrompt:                "Rename detection threshold in percent (empty for git's default):",
+		InvalidRenameThreshold:               "The rename detection threshold must be a number between 0 and 100",
+		FindCopies:                           "Detect copies",
+		FindCopiesTooltip:                    "Show added files that are copies of modified files as copies (--find-copies).",
+		GitDefault:                           "git's default",
+		CantChangeDiffAlgorithmError:         "Cannot change the diff algorithm while building a patch, because the patch refers to the lines of the current diff.",
+		SideBySideDiff:                       "Side-by-side view",
+		SideBySideDiffTooltip:                "Show the old and the new version of changed lines next to each other in the main view. The staging and patch building views keep showing one below the other, so that lines can be selected.",
+		ViewLfsOptions:                       "View LFS options",
+		ViewLfsOptionsTooltip:                "View Git LFS options: lock and unlock files, and download or prune LFS objects.",
+		LfsOptions:                           "LFS options",
+		LfsShowLocks:                         "Show locks",
+		LfsShowLocksTooltip:                  "Show the files that are locked on the LFS server, so that they can be unlocked.",
+		LfsLocksTitle:                        "LFS locks",
+		NoLfsLocks:                           "No files are locked",
+		LfsLockFile:                          "Lock file",
+		LfsLockFileTooltip:                   "Lock a file on the LFS server so that nobody else can push changes to it.",
+		LfsLockFilePrompt:                    "Path of the file to lock:",
+		LfsUnlock:                            "Unlock",
+		LfsForceUnlock:                       "Force unlock",
+		LfsForceUnlockTooltip:                "Release a lock that somebody else holds.",
+		LfsForceUnlockPrompt:                 "Are you sure you want to release the lock that {{.owner}} holds on '{{.path}}'?",
+		LfsFetch:                             "Fetch LFS objects",
+		LfsFetchTooltip:                      "Download the LFS objects of the current checkout without changing the working tree.",
+		LfsPull:                              "Pull LFS objects",
+		LfsPullTooltip:                       "Download the LFS objects of the current checkout and replace their pointers in the working tree.",
+		LfsPrune:                             "Prune LFS objects",
+		LfsPruneTooltip:                      "Delete the local copies of LFS objects that are old and have been pushed.",
+		LfsPrunePrompt:                       "Are you sure you want to delete the local copies of old LFS objects? They can be downloaded again.",
+		LoadingLocksStatus:                   "Loading locks",
+		LockingStatus:                        "Locking",
+		UnlockingStatus:                      "Unlocking",
+		LfsObjectChanged:                     "binary LFS object changed (size {{.oldSize}} → {{.newSize}})",
+		LfsObjectAdded:                       "binary LFS object added (size {{.size}})",
+		LfsObjectDeleted:                     "binary LFS object deleted (size {{.size}})",
+		EditConfigValue:                      "Edit",
+		RemoveConfigValue:                    "Remove",
+		DeleteUnmergedBranchesWarning:        "These branches aren't merged, so their commits will be lost:\n\n{{.branches}}",
+		CustomCommandRangeSelectNotSupported: "Custom commands can't act on a range of selected lines",
+		LoadingAuditLog:                      "Loading audit log",
+		DiscardingStatus:                     "Discarding",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                    "Checkout commit",
@@ -1547,6 +2010,25 @@ func EnglishTranslationSet() TranslationSet {
 			BisectMark:                        "Bisect mark",
 			RemoveWorktree:                    "Remove worktree",
 			AddWorktree:                       "Add worktree",
+			PruneRemote:                       "Prune remote",
+			DeleteRemoteBranches:              "Delete remote branches",
+			UpdateRemotePushUrl:               "Update remote push URL",
+			UpdateRemoteFetchRefspec:          "Update remote fetch refspec",
+			DeleteLocalBranches:               "Delete local branches",
+			CreateSignedTag:                   "Create signed tag",
+			PushTags:                          "Push tags",
+			FetchTags:                         "Fetch tags",
+			DeleteRemoteTags:                  "Delete remote tags",
+			DeleteLocalTags:                   "Delete local tags",
+			RestoreStash:                      "Restore stash",
+			PluginCommand:                     "Run command from plugin '{{.plugin}}'",
+			SetPullStrategy:                   "Set pull strategy",
+			RecoverDiscardedChanges:           "Recover discarded changes",
+			LfsLock:                           "Lock LFS file",
+			LfsUnlock:                         "Unlock LFS file",
+			LfsFetch:                          "Fetch LFS objects",
+			LfsPull:                           "Pull LFS objects",
+			LfsPrune:                          "Prune LFS objects",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -24,6 +24,12 @@ func (self *Git) RemoteTagDeleted(ref string, tagName string) *Git {
 	})
 }
 
+func (self *Git) ConfigValue(key string, expectedValue string) *Git {
+	return self.expect([]string{"git", "config", "--get", key}, func(s string) (bool, string) {
+		return s == expectedValue, fmt.Sprintf("Expected git config %s to be '%s', but got '%s'", key, expectedValue, s)
+	})
+}
+
 func (self *Git) assert(cmdArgs []string, expected string) *Git {
 	self.expect(cmdArgs, func(output string) (bool, string) {
 		return output == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, output)
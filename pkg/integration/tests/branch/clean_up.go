@@ -0,0 +1,93 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CleanUp = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete local branches which are merged, squash-merged or whose upstream is gone",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.NewBranch("merged")
+		shell.Checkout("master")
+
+		shell.NewBranch("squashed")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("add file")
+		shell.UpdateFileAndAdd("file", "updated content")
+		shell.Commit("update file")
+		shell.Checkout("master")
+		shell.RunCommand([]string{"git", "merge", "--squash", "squashed"})
+		shell.Commit("squashed")
+
+		shell.NewBranch("unmerged")
+		shell.CreateFileAndAdd("unmerged-file", "content")
+		shell.Commit("unmerged")
+		shell.Checkout("master")
+
+		shell.NewBranch("gone")
+		shell.CreateFileAndAdd("gone-file", "content")
+		shell.Commit("gone")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("gone", "origin/gone")
+		shell.RunCommand([]string{"git", "-C", "../origin", "branch", "-D", "gone"})
+		shell.RunCommand([]string{"git", "fetch", "--prune", "origin"})
+
+		shell.AddWorktree("master", "../linked-worktree", "in-worktree")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Press(keys.Branches.CleanUpBranches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branches to clean up")).
+			Lines(
+				Contains("Delete selected branches (0)").IsSelected(),
+				Contains("Toggle all"),
+				Contains("[ ] gone").Contains("upstream gone"),
+				Contains("[ ] squashed").Contains("squash-merged into origin/master"),
+				Contains("[ ] merged").Contains("merged into origin/master"),
+				Contains("[ ] in-worktree").Contains("checked out in worktree linked-worktree"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Toggle all")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branches to clean up")).
+			Lines(
+				Contains("Delete selected branches (3)"),
+				Contains("Toggle all").IsSelected(),
+				Contains("[x] gone"),
+				Contains("[x] squashed"),
+				Contains("[x] merged"),
+				Contains("[ ] in-worktree"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Delete selected branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete selected branches")).
+			Content(
+				Contains("gone\nsquashed\nmerged").
+					Contains("These branches aren't merged, so their commits will be lost:\n\ngone"),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("unmerged"),
+				Contains("in-worktree"),
+			)
+	},
+})
@@ -0,0 +1,44 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowSignatureStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show whether commits are signed, and the verification output in the commit details",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.Git.Log.ShowSignatureStatus = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunCommand([]string{"ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "", "-f", "../signing-key"})
+		shell.RunShellCommand(`echo "CI@example.com $(cat ../signing-key.pub)" > ../allowed-signers`)
+		shell.SetConfig("gpg.format", "ssh")
+		shell.SetConfig("user.signingKey", "../signing-key.pub")
+		shell.SetConfig("gpg.ssh.allowedSignersFile", "../allowed-signers")
+
+		shell.EmptyCommit("unsigned commit")
+		shell.RunCommand([]string{"git", "commit", "--allow-empty", "-S", "-m", "signed commit"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("✓").Contains("signed commit").IsSelected(),
+				Contains("-").Contains("unsigned commit"),
+			)
+
+		t.Views().Main().
+			Content(Contains(`Good "git" signature for CI@example.com`))
+
+		// the expanded view shows who signed the commit
+		t.Views().Commits().
+			Press(keys.Universal.NextScreenMode).
+			Lines(
+				Contains("✓ CI@example.com").Contains("signed commit").IsSelected(),
+				Contains("-").Contains("unsigned commit"),
+			)
+	},
+})
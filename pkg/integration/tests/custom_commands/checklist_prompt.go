@@ -0,0 +1,81 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ChecklistPrompt = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a checklist prompt to pick several branches",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("one")
+		shell.NewBranch("two")
+		shell.NewBranch("three")
+		shell.Checkout("master")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `echo "{{.Form.Branches}}|{{range .FormLists.Branches}}[{{.}}]{{end}}" > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Type:        "checklist",
+						Title:       "Choose branches",
+						Key:         "Branches",
+						Command:     "git branch --format='%(refname:short)' --list 't*'",
+						Filter:      "(?P<branch>.*)",
+						ValueFormat: "{{ .branch }}",
+						LabelFormat: "branch {{ .branch }}",
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose branches")).
+			Lines(
+				Contains("Continue with selected (0)").IsSelected(),
+				Contains("Toggle all"),
+				Contains("[ ] branch three"),
+				Contains("[ ] branch two"),
+				Contains("Cancel"),
+			).
+			Select(Contains("branch three")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose branches")).
+			Select(Contains("branch two")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose branches")).
+			Lines(
+				Contains("Continue with selected (2)"),
+				Contains("Toggle all"),
+				Contains("[x] branch three"),
+				Contains("[x] branch two").IsSelected(),
+				Contains("Cancel"),
+			).
+			Select(Contains("Continue with selected")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("three two|[three][two]\n"))
+	},
+})
@@ -0,0 +1,54 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DisabledWithLineRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "A global custom command refuses to run while a range of lines is selected in the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("one")
+		shell.UpdateFile("file", "one\ntwo\nthree\n")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "global",
+				Command: "touch myfile",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleDragSelect).
+			NavigateToLine(Contains("+three")).
+			Press("X")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Custom commands can't act on a range of selected lines")).
+			Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleDragSelect).
+			Press("X")
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("file"),
+				Contains("myfile"),
+			)
+	},
+})
@@ -0,0 +1,54 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilePickerPrompt = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a filePicker prompt to pick a path from the repo's file tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/apple", "apple")
+		shell.CreateFileAndAdd("dir/banana", "banana")
+		shell.Commit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `cp {{.Form.Path | quote}} output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Type:  "filePicker",
+						Title: "Which file?",
+						Key:   "Path",
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Which file?")).
+			Type("ban").
+			SuggestionLines(
+				Contains("dir/banana"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("banana"))
+	},
+})
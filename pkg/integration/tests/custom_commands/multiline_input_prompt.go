@@ -0,0 +1,56 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MultilineInputPrompt = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a multilineInput prompt to enter a message with a body",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `printf '%s' {{.Form.Message | quote}} > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Type:         "multilineInput",
+						Title:        "Release notes",
+						Key:          "Message",
+						InitialValue: "v1.0",
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Release notes")).
+			InitialText(Equals("v1.0")).
+			SwitchToDescription().
+			Title(Equals("Body")).
+			Type("first line").
+			AddNewline().
+			Type("second line").
+			SwitchToSummary().
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("v1.0\n\nfirst line\nsecond line"))
+	},
+})
@@ -0,0 +1,75 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OutputMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a command's JSON output as a menu and run a follow-up action on the chosen entry",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:         "a",
+				Context:     "localBranches",
+				Description: "Pick a ticket",
+				Command:     `printf '[{"id": 12, "title": "Fix the thing"}, {"id": 34, "title": "Add the feature"}]'`,
+				OutputMenu: &config.CustomCommandOutputMenu{
+					Title:   "Tickets for {{.CheckedOutBranch.Name}}",
+					Columns: []string{"#{{.Item.id}}", "{{.Item.title}}"},
+					Actions: []config.CustomCommandOutputAction{
+						{
+							Key:         "b",
+							Description: "Create branch",
+							Command:     "git checkout -b ticket-{{.Item.id}}",
+						},
+						{
+							Key:         "e",
+							Description: "Echo title",
+							Command:     "echo {{.Item.title | quote}}",
+							ShowOutput:  true,
+						},
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tickets for master")).
+			Lines(
+				Contains("#12").Contains("Fix the thing").IsSelected(),
+				Contains("#34").Contains("Add the feature"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Add the feature")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Custom command")).
+			Lines(
+				Contains("b").Contains("Create branch").IsSelected(),
+				Contains("e").Contains("Echo title"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("ticket-34"),
+				Contains("master"),
+			)
+	},
+})
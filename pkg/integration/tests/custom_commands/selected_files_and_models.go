@@ -0,0 +1,44 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedFilesAndModels = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Access all files below the selected directory and the repo's models from a custom command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("feature")
+		shell.Checkout("master")
+		shell.CreateFile("dir/one", "one")
+		shell.CreateFile("dir/two", "two")
+		shell.CreateFile("three", "three")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:        "a",
+				Context:    "files",
+				Command:    `echo "{{range .SelectedFiles}}{{.Name}} {{end}}| {{range .LocalBranches}}{{.Name}} {{end}}| {{.RepoName}}"`,
+				ShowOutput: true,
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("dir").IsSelected(),
+				Contains("one"),
+				Contains("two"),
+				Contains("three"),
+			).
+			Press("a")
+
+		t.ExpectPopup().Alert().
+			Content(Contains("dir/one dir/two | master feature | repo"))
+	},
+})
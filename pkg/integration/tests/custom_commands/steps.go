@@ -0,0 +1,72 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var stepsCustomCommand = config.CustomCommand{
+	Key:     "a",
+	Context: "files",
+	Steps: []config.CustomCommandStep{
+		{
+			Command: "git rev-parse --abbrev-ref HEAD",
+			Key:     "Branch",
+		},
+		{
+			Prompt: &config.CustomCommandPrompt{
+				Type:  "menu",
+				Title: "Kind of change on {{.Form.Branch}}",
+				Key:   "Kind",
+				Options: []config.CustomCommandMenuOption{
+					{Value: "feature"},
+					{Value: "hotfix"},
+				},
+			},
+		},
+		{
+			If: `eq .Form.Kind "feature"`,
+			Prompt: &config.CustomCommandPrompt{
+				Type:  "input",
+				Title: "Feature name",
+				Key:   "Name",
+			},
+		},
+	},
+	Command: `echo "{{.Form.Branch}} {{.Form.Kind}} {{.Form.Name}}" > output.txt`,
+}
+
+var Steps = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run a chain of steps, capturing a command's output and showing a prompt conditionally",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{stepsCustomCommand}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Kind of change on master")).
+			Select(Contains("feature")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Feature name")).
+			Type("login").
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("master feature login\n"))
+	},
+})
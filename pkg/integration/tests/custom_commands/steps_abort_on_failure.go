@@ -0,0 +1,48 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StepsAbortOnFailure = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Abort a chain of steps when one of its commands fails",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Steps: []config.CustomCommandStep{
+					{Command: "echo one > one.txt"},
+					{Command: "echo 'step failed' && false"},
+					{Command: "echo two > two.txt"},
+				},
+				Command: "echo done > done.txt",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("step failed")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("one.txt"),
+			)
+
+		t.FileSystem().PathNotPresent("two.txt")
+		t.FileSystem().PathNotPresent("done.txt")
+	},
+})
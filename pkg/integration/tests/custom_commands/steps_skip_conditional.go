@@ -0,0 +1,36 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StepsSkipConditional = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Skip a step whose condition is false",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{stepsCustomCommand}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Kind of change on master")).
+			Select(Contains("hotfix")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.FileSystem().FileContent("output.txt", Equals("master hotfix \n"))
+	},
+})
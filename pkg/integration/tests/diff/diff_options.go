@@ -0,0 +1,111 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Change how diffs are computed through the diff options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("original", "one\ntwo\nthree\nfour\nfive\n")
+		shell.Commit("add original")
+		shell.CopyFile("original", "copy")
+		shell.UpdateFile("original", "one\ntwo\nthree\nfour\nfive\nsix\n")
+		shell.GitAddAll()
+		shell.Commit("copy original")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("copy original").IsSelected(),
+				Contains("add original"),
+			)
+
+		t.Views().Main().
+			Content(Contains("new file mode")).
+			Content(DoesNotContain("copy from original"))
+
+		t.GlobalPress(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Lines(
+				Contains("Diff algorithm").Contains("git's default").IsSelected(),
+				Contains("[ ] Colour moved lines"),
+				Contains("Rename detection threshold").Contains("git's default"),
+				Contains("[ ] Detect copies"),
+				Contains("[ ] Side-by-side view"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Detect copies")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("copy from original")).
+			Content(Contains("copy to copy"))
+
+		// the menu is opened again so that more options can be changed
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Lines(
+				Contains("Diff algorithm"),
+				Contains("[ ] Colour moved lines"),
+				Contains("Rename detection threshold"),
+				Contains("[x] Detect copies").IsSelected(),
+				Contains("[ ] Side-by-side view"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Rename detection threshold")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Rename detection threshold in percent (empty for git's default):")).
+			Type("200").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("The rename detection threshold must be a number between 0 and 100")).
+			Confirm()
+
+		t.GlobalPress(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Select(Contains("Diff algorithm")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff algorithm")).
+			Lines(
+				Contains("[x] git's default").IsSelected(),
+				Contains("[ ] myers"),
+				Contains("[ ] minimal"),
+				Contains("[ ] patience"),
+				Contains("[ ] histogram"),
+				Contains("Cancel"),
+			).
+			Select(Contains("histogram")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Lines(
+				Contains("Diff algorithm").Contains("histogram").IsSelected(),
+				Contains("[ ] Colour moved lines"),
+				Contains("Rename detection threshold").Contains("git's default"),
+				Contains("[x] Detect copies"),
+				Contains("[ ] Side-by-side view"),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Main().
+			Content(Contains("copy from original"))
+	},
+})
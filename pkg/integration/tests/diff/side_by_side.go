@@ -0,0 +1,80 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SideBySide = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the old and the new version of changed lines next to each other, and click a line of either half to stage it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\nold second line\nthird line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nnew second line\nadded line\nthird line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.DiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Select(Contains("Side-by-side view")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			Lines(
+				Contains("Diff algorithm"),
+				Contains("[ ] Colour moved lines"),
+				Contains("Rename detection threshold"),
+				Contains("[ ] Detect copies"),
+				Contains("[x] Side-by-side view").IsSelected(),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Main().
+			ContainsLines(
+				Contains("@@ -1,3 +1,4 @@"),
+				Contains("1  first line").Contains("│1  first line"),
+				Contains("2 -old second line").Contains("│2 +new second line"),
+				DoesNotContain("-").Contains("│3 +added line"),
+				Contains("3  third line").Contains("│4  third line"),
+			)
+
+		// the row with the added line stands for that line of the diff, which is
+		// what gets selected in the staging view
+		t.Views().Main().
+			Click(1, 7)
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains(" first line"),
+				Contains("-old second line"),
+				Contains("+new second line"),
+				Contains("+added line").IsSelected(),
+				Contains(" third line"),
+			).
+			PressEscape()
+
+		// clicking the right half of a row that shows a removed and an added
+		// line selects the added one
+		t.Views().Main().
+			Click(80, 6)
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains(" first line"),
+				Contains("-old second line"),
+				Contains("+new second line").IsSelected(),
+				Contains("+added line"),
+				Contains(" third line"),
+			)
+	},
+})
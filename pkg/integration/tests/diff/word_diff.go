@@ -0,0 +1,78 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var WordDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cycle through highlighting changed words, characters and nothing in the diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\nold second line\nthird line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nnew second line\nthird line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().ContainsLines(
+			Contains(` first line`),
+			Contains(`-old second line`),
+			Contains(`+new second line`),
+			Contains(` third line`),
+		)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.CycleWordDiffInDiffView)
+
+		// the changed words are highlighted within the removed and added lines,
+		// which keep a line each
+		t.Views().Main().ContainsLines(
+			Contains(` first line`),
+			Contains(`-old second line`),
+			Contains(`+new second line`),
+			Contains(` third line`),
+		)
+
+		t.Views().Files().
+			Press(keys.Universal.CycleWordDiffInDiffView)
+
+		t.Views().Main().ContainsLines(
+			Contains(` first line`),
+			Contains(`-old second line`),
+			Contains(`+new second line`),
+			Contains(` third line`),
+		)
+
+		// clicking the added line selects it in the staging view, which keeps
+		// showing whole lines, with the changes highlighted within them
+		t.Views().Main().
+			Click(1, 7)
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Contains(` first line`),
+				Contains(`-old second line`),
+				Contains(`+new second line`).IsSelected(),
+				Contains(` third line`),
+			).
+			Press(keys.Universal.CycleWordDiffInDiffView).
+			ContainsLines(
+				Contains(` first line`),
+				Contains(`-old second line`),
+				Contains(`+new second line`).IsSelected(),
+				Contains(` third line`),
+			).
+			PressEscape()
+
+		t.Views().Main().ContainsLines(
+			Contains(` first line`),
+			Contains(`-old second line`),
+			Contains(`+new second line`),
+			Contains(` third line`),
+		)
+	},
+})
@@ -0,0 +1,106 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+func lfsPointer(size int) string {
+	return fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%064d\nsize %d\n", size, size)
+}
+
+var LfsFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Files managed by git-lfs are labelled in the files panel, and their diffs are summed up",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// git-lfs isn't needed for this: without it, git leaves the pointers
+		// as they are
+		shell.CreateFileAndAdd(".gitattributes", "*.psd filter=lfs diff=lfs merge=lfs -text\n")
+		shell.CreateFileAndAdd("cover.psd", lfsPointer(2048))
+		shell.CreateFileAndAdd("logo.psd", lfsPointer(1500))
+		shell.Commit("initial commit")
+		shell.UpdateFileAndAdd("cover.psd", lfsPointer(3_500_000))
+		shell.Commit("update cover")
+		// not matched by .gitattributes, so only its content tells that it's a
+		// pointer
+		shell.CreateFileAndAdd("model.bin", lfsPointer(1024))
+		shell.Commit("add model")
+
+		shell.UpdateFile("cover.psd", lfsPointer(4_000_000))
+		shell.UpdateFile("logo.psd", "actual image content")
+		shell.CreateFile("notes.txt", "hello")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M cover.psd (LFS pointer)").IsSelected(),
+				Equals(" M logo.psd (LFS)"),
+				Equals("?? notes.txt"),
+			)
+
+		t.Views().Main().Content(Equals("binary LFS object changed (size 3.5 MB → 4.0 MB)"))
+
+		t.Views().Files().
+			NavigateToLine(Contains("logo.psd"))
+
+		t.Views().Main().Content(Equals("binary LFS object changed (size 1.5 KB → 20 B)"))
+
+		t.Views().Files().
+			NavigateToLine(Contains("notes.txt"))
+
+		t.Views().Main().Content(Contains("+hello"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewLfsOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("LFS options")).
+			Lines(
+				Contains("Show locks"),
+				Contains("Lock file"),
+				Contains("Fetch LFS objects"),
+				Contains("Pull LFS objects"),
+				Contains("Prune LFS objects"),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add model").IsSelected(),
+				Contains("update cover"),
+				Contains("initial commit"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("model.bin").IsSelected(),
+			)
+
+		t.Views().Main().Content(Equals("binary LFS object added (size 1.0 KB)"))
+
+		t.Views().CommitFiles().
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			NavigateToLine(Contains("update cover")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("cover.psd").IsSelected(),
+			)
+
+		t.Views().Main().Content(Equals("binary LFS object changed (size 2.0 KB → 3.5 MB)"))
+	},
+})
@@ -0,0 +1,103 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RecoverDiscardedChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard the changes in a file, nuke the working tree, and recover the files from the snapshots taken before",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "original content\n")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file", "new content\n")
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file").IsSelected(),
+				Contains("?? untracked"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("file")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Contains("?? untracked").IsSelected(),
+			).
+			Press(keys.Files.ViewResetOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("")).
+					Select(Contains("Nuke working tree")).
+					Confirm()
+			}).
+			IsEmpty()
+
+		openRecoveryMenu := func() {
+			t.Views().Files().Press(keys.Files.ViewResetOptions)
+			t.ExpectPopup().Menu().
+				Title(Equals("")).
+				Select(Contains("Recover discarded changes")).
+				Confirm()
+		}
+
+		openRecoveryMenu()
+		t.ExpectPopup().Menu().
+			Title(Equals("Recover discarded changes")).
+			Lines(
+				Contains("Nuke working tree").IsSelected(),
+				Contains("Discard all changes in file: file"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		// the file had no changes anymore when the working tree was nuked
+		t.ExpectPopup().Menu().
+			Title(Equals("Nuke working tree")).
+			Lines(
+				Contains("Restore all files").IsSelected(),
+				Contains("untracked"),
+				Contains("Cancel"),
+			).
+			Select(Contains("untracked")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("?? untracked"),
+			)
+		t.FileSystem().FileContent("untracked", Equals("untracked content\n"))
+
+		openRecoveryMenu()
+		t.ExpectPopup().Menu().
+			Title(Equals("Recover discarded changes")).
+			Select(Contains("Discard all changes in file: file")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard all changes in file: file")).
+			Lines(
+				Contains("Restore all files").IsSelected(),
+				Contains("file"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file"),
+				Contains("?? untracked"),
+			)
+		t.FileSystem().FileContent("file", Equals("new content\n"))
+	},
+})
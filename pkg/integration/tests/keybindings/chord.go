@@ -0,0 +1,44 @@
+package keybindings
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Chord = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Bind actions to key sequences and pick the second key from the popup shown after the first",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Keybinding.Files.CommitChanges = "X c"
+		cfg.UserConfig.Keybinding.Files.AmendLastCommit = "X a"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("A  myfile").IsSelected(),
+			).
+			Press("X")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("X")).
+			Lines(
+				Contains("c").Contains("Commit").IsSelected(),
+				Contains("a").Contains("Amend last commit"),
+				Contains("Cancel"),
+			)
+
+		t.Views().Menu().Press("c")
+
+		t.ExpectPopup().CommitMessagePanel().Type("my commit message").Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("my commit message"),
+			)
+	},
+})
@@ -0,0 +1,42 @@
+package keybindings
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ChordConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "A chord starting with a key that has a single-key binding in the same view is reported and left out, while all other keybindings keep working",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X a",
+				Context:     "files",
+				Command:     "touch from-chord",
+				Description: "Chord",
+			},
+			{
+				Key:         "X",
+				Context:     "files",
+				Command:     "touch from-single-key",
+				Description: "Single key",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Keybinding 'X a' (Chord) conflicts with keybinding 'X' (Single key) in the same view")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press("X").
+			Lines(
+				Contains("from-single-key"),
+			)
+	},
+})
@@ -0,0 +1,39 @@
+package keybindings
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ConflictsMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the keybindings that are shadowed by the user's config in the keybindings menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Keybinding.Files.CommitChanges = "P"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OptionMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Keybindings")).
+			TopLines(
+				Contains("View keybinding conflicts (3)"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Keybinding conflicts")).
+			Lines(
+				Contains("P").Contains("files").Contains("Commit").Contains("shadows Push"),
+				Contains("P").Contains("stagingSecondary").Contains("Commit").Contains("shadows Push"),
+				Contains("P").Contains("staging ").Contains("Commit").Contains("shadows Push"),
+				Contains("Cancel"),
+			)
+	},
+})
@@ -0,0 +1,72 @@
+package keybindings
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NestedChord = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Bind custom commands to leader-key sequences that share a prefix and walk through the nested popups",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X b n",
+				Context:     "global",
+				Command:     "git branch new-branch",
+				Description: "Create new-branch",
+			},
+			{
+				Key:         "X b o",
+				Context:     "global",
+				Command:     "git branch other-branch",
+				Description: "Create other-branch",
+			},
+			{
+				Key:         "X t",
+				Context:     "global",
+				Command:     "git tag new-tag",
+				Description: "Create new-tag",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press("X")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("X")).
+			Lines(
+				Contains("b").Contains("Keybindings starting with 'X b'").IsSelected(),
+				Contains("t").Contains("Create new-tag"),
+				Contains("Cancel"),
+			)
+
+		t.Views().Menu().Press("b")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("X b")).
+			Lines(
+				Contains("n").Contains("Create new-branch").IsSelected(),
+				Contains("o").Contains("Create other-branch"),
+				Contains("Cancel"),
+			)
+
+		t.Views().Menu().Press("o")
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("other-branch"),
+			)
+	},
+})
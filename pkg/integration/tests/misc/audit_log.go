@@ -0,0 +1,81 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AuditLog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the actions and commands recorded in the audit log, and search them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.UserConfig.AuditLog.Enabled = true
+		config.UserConfig.OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one")
+		shell.Commit("one")
+		shell.CreateFile("file2", "two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("file2").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("View audit log")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Audit log")).
+			Lines(
+				Contains("Search all sessions").Contains("2 entries").IsSelected(),
+				Contains("repo").Contains("2 entries"),
+				Contains("Cancel"),
+			).
+			Select(Contains("repo")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(MatchesRegexp(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`)).
+			Lines(
+				Contains("Stage file").IsSelected(),
+				Contains("git add -- file2").Contains("exit 0"),
+				Contains("Cancel"),
+			).
+			Select(Contains("git add -- file2")).
+			Tooltip(Contains("Repo: ").Contains("repo")).
+			Confirm()
+
+		t.FileSystem().FileContent("clipboard", Equals("git add -- file2"))
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("View audit log")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Audit log")).
+			Select(Contains("Search all sessions")).
+			Confirm()
+
+		t.ExpectSearch().
+			Type("stage").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("All sessions")).
+			Lines(
+				Contains("Stage file").IsSelected(),
+			)
+	},
+})
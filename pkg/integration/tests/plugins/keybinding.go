@@ -0,0 +1,48 @@
+package plugins
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// Plugins in these tests are shell scripts that don't parse the messages they
+// receive; they rely on lazygit numbering its requests from 1.
+const keybindingPlugin = `
+read -r initialize
+echo '{"jsonrpc":"2.0","id":1,"result":{"keybindings":[{"id":"create-branch","context":"localBranches","key":"X","description":"Create branch from plugin"}]}}'
+
+read -r invoke
+echo '{"jsonrpc":"2.0","id":"git","method":"runGitCommand","params":{"args":["branch","from-plugin"]}}'
+read -r reply
+echo '{"jsonrpc":"2.0","method":"refresh"}'
+echo '{"jsonrpc":"2.0","id":2,"result":null}'
+
+read -r shutdown
+`
+
+var Keybinding = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Invoke a keybinding registered by a plugin, which runs a git command through lazygit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile(".git/plugin.sh", keybindingPlugin)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Plugins = []config.PluginConfig{
+			{Name: "test", Command: "sh .git/plugin.sh"},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press("X").
+			Lines(
+				Contains("master"),
+				Contains("from-plugin"),
+			)
+	},
+})
@@ -0,0 +1,61 @@
+package plugins
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+const menuPlugin = `
+read -r initialize
+echo '{"jsonrpc":"2.0","id":1,"result":{"menuItems":[{"id":"greet","context":"global","label":"Greet","description":"Say hello"}]}}'
+
+read -r invoke
+echo '{"jsonrpc":"2.0","id":"confirm","method":"confirm","params":{"title":"Greet","message":"Render a greeting?"}}'
+read -r reply
+case "$reply" in
+	*'"confirmed":true'*)
+		echo '{"jsonrpc":"2.0","id":"render","method":"renderMain","params":{"title":"Greeting","content":"Hello from the plugin"}}'
+		read -r reply
+		;;
+esac
+echo '{"jsonrpc":"2.0","id":2,"result":null}'
+
+read -r shutdown
+`
+
+var MenuAndPopups = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Invoke a plugin's menu item, answer its confirmation and see what it renders to the main view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile(".git/plugin.sh", menuPlugin)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Plugins = []config.PluginConfig{
+			{Name: "test", Command: "sh .git/plugin.sh"},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenPluginsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Plugins")).
+			Lines(
+				Contains("Greet").Contains("Say hello").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Greet")).
+			Content(Equals("Render a greeting?")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Greeting")).
+			Content(Contains("Hello from the plugin"))
+	},
+})
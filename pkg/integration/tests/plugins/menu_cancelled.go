@@ -0,0 +1,56 @@
+package plugins
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+const menuCancelledPlugin = `
+read -r initialize
+echo '{"jsonrpc":"2.0","id":1,"result":{"keybindings":[{"id":"broken","context":"global","key":"<not-a-key>","description":"Broken"},{"id":"pick","context":"global","key":"X","description":"Pick a fruit"}]}}'
+
+read -r invoke
+echo '{"jsonrpc":"2.0","id":"menu","method":"menu","params":{"title":"Fruit","items":[{"label":"Apple","value":"apple"}]}}'
+read -r reply
+case "$reply" in
+	*'"cancelled":true'*)
+		echo '{"jsonrpc":"2.0","id":"render","method":"renderMain","params":{"title":"Fruit","content":"No fruit picked"}}'
+		read -r reply
+		;;
+esac
+echo '{"jsonrpc":"2.0","id":2,"result":null}'
+
+read -r shutdown
+`
+
+var MenuCancelled = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Closing a plugin's menu with escape replies with a cancelled result, and a keybinding with an invalid key is skipped",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile(".git/plugin.sh", menuCancelledPlugin)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.UserConfig.Plugins = []config.PluginConfig{
+			{Name: "test", Command: "sh .git/plugin.sh"},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press("X")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fruit")).
+			Lines(
+				Contains("Apple").IsSelected(),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Main().
+			Title(Equals("Fruit")).
+			Content(Contains("No fruit picked"))
+	},
+})
@@ -0,0 +1,62 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RecoverDroppedStash = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Find a dropped stash among the dangling commits and restore it as a stash entry",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file", "content")
+		shell.GitAddAll()
+		shell.Stash("stash one")
+		shell.RunCommand([]string{"git", "stash", "drop"})
+		// only the reflog of refs/stash keeps all but the newest stash, which
+		// doesn't make them lost
+		shell.CreateFileAndAdd("file", "kept one")
+		shell.Stash("kept one")
+		shell.CreateFileAndAdd("file", "kept two")
+		shell.Stash("kept two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Lines(
+				Contains("On master: kept two"),
+				Contains("On master: kept one"),
+			)
+
+		t.Views().ReflogCommits().
+			Focus().
+			Press(keys.Commits.ViewRecoveryOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recover lost commits")).
+			Select(Contains("Dangling commits and dropped stashes")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Dangling commits and dropped stashes")).
+			Lines(
+				Contains("stash").Contains("On master: stash one").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Recover")).
+			Select(Contains("Restore as stash entry")).
+			Confirm()
+
+		t.Views().Stash().
+			Lines(
+				Contains("On master: stash one"),
+				Contains("On master: kept two"),
+				Contains("On master: kept one"),
+			)
+	},
+})
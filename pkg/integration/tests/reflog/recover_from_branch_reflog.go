@@ -0,0 +1,71 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RecoverFromBranchReflog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore a commit from the reflog of a branch that is not checked out as a new branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("two")
+		shell.Checkout("master")
+		// move the branch without checking it out, so that the lost commit
+		// only shows up in the branch's own reflog
+		shell.RunCommand([]string{"git", "branch", "-f", "feature", "HEAD"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			Press(keys.Commits.ViewRecoveryOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recover lost commits")).
+			Select(Contains("Reflog of branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Reflog of branch")).
+			Type("feature").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Reflog of 'feature'")).
+			Lines(
+				Contains("branch: Reset to HEAD").IsSelected(),
+				Contains("commit: two"),
+				Contains("branch: Created from HEAD"),
+				Contains("Cancel"),
+			).
+			Select(Contains("commit: two")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Recover")).
+			Select(Contains("Restore as new branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("New branch name")).
+			Type("recovered").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.Views().Branches().
+			Lines(
+				Contains("recovered").IsSelected(),
+				Contains("master"),
+				Contains("feature"),
+			)
+	},
+})
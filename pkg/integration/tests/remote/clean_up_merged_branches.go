@@ -0,0 +1,74 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CleanUpMergedBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete a selection of the remote branches which are merged into a main branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("merged-one")
+		shell.NewBranch("merged-two")
+		shell.NewBranch("unmerged")
+		shell.EmptyCommit("two")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Press(keys.Branches.ViewRemoteOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Remote 'origin'")).
+			Select(Contains("Clean up merged remote branches")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branches on 'origin' merged into a main branch")).
+			Lines(
+				Contains("Delete selected branches (0)").IsSelected(),
+				Contains("Toggle all"),
+				Contains("[ ] merged-one"),
+				Contains("[ ] merged-two"),
+				Contains("Cancel"),
+			).
+			Select(Contains("merged-two")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Branches on 'origin' merged into a main branch")).
+			Lines(
+				Contains("Delete selected branches (1)"),
+				Contains("Toggle all"),
+				Contains("[ ] merged-one"),
+				Contains("[x] merged-two").IsSelected(),
+				Contains("Cancel"),
+			).
+			Select(Contains("Delete selected branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete selected branches")).
+			Content(Contains("merged-two").DoesNotContain("merged-one")).
+			Confirm()
+
+		t.Views().Remotes().
+			IsFocused().
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("merged-one"),
+				Contains("unmerged"),
+			)
+	},
+})
@@ -0,0 +1,85 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditPushUrls = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit and remove the push URLs of a remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.RunCommand([]string{"git", "remote", "add", "origin", "https://example.com/fetch.git"})
+		shell.RunCommand([]string{"git", "remote", "set-url", "--add", "--push", "origin", "https://example.com/first.git"})
+		shell.RunCommand([]string{"git", "remote", "set-url", "--add", "--push", "origin", "https://example.com/second.git"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		openPushUrls := func() {
+			t.Views().Remotes().
+				Focus().
+				Lines(
+					Contains("origin").IsSelected(),
+				).
+				Press(keys.Branches.ViewRemoteOptions)
+
+			t.ExpectPopup().Menu().
+				Title(Equals("Remote 'origin'")).
+				Select(Contains("Edit push URLs")).
+				Confirm()
+		}
+
+		openPushUrls()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push URLs of 'origin'")).
+			Lines(
+				Contains("https://example.com/first.git").IsSelected(),
+				Contains("https://example.com/second.git"),
+				Contains("Add push URL"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("https://example.com/first.git")).
+			Lines(
+				Contains("Edit").IsSelected(),
+				Contains("Remove"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter updated push URL:")).
+			InitialText(Equals("https://example.com/first.git")).
+			Clear().
+			Type("https://example.com/edited.git").
+			Confirm()
+
+		openPushUrls()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push URLs of 'origin'")).
+			Select(Contains("https://example.com/second.git")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("https://example.com/second.git")).
+			Select(Contains("Remove")).
+			Confirm()
+
+		openPushUrls()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push URLs of 'origin'")).
+			Lines(
+				Contains("https://example.com/edited.git").IsSelected(),
+				Contains("Add push URL"),
+				Contains("Cancel"),
+			).
+			Cancel()
+	},
+})
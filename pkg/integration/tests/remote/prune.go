@@ -0,0 +1,52 @@
+package remote
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Prune = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Prune remote-tracking branches which no longer exist on the remote, after previewing them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-apple")
+		shell.NewBranch("branch-grape")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.RemoveRemoteBranch("origin", "branch-apple")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.ViewRemoteOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Remote 'origin'")).
+			Select(Contains("Prune stale remote branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Prune stale remote branches")).
+			Content(Contains("origin/branch-apple").DoesNotContain("origin/branch-grape")).
+			Confirm()
+
+		t.Views().Remotes().
+			IsFocused().
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("branch-grape"),
+				Contains("master"),
+			)
+	},
+})
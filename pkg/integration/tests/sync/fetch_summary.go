@@ -0,0 +1,49 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchSummary = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch all remotes and see which refs were updated, created or deleted on each of them, and which remotes failed, leaving out remotes with skipFetchAll",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("fetch.prune", "true")
+
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch_to_remove")
+		shell.Checkout("master")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.RunCommand([]string{"git", "remote", "add", "unreachable", "../does-not-exist"})
+		// left out by 'git fetch --all', so it's not fetched at all
+		shell.RunCommand([]string{"git", "remote", "add", "skipped", "../does-not-exist-either"})
+		shell.SetConfig("remote.skipped.skipFetchAll", "true")
+
+		shell.RemoveRemoteBranch("origin", "branch_to_remove")
+		shell.RunCommand([]string{"git", "-C", "../origin", "branch", "new_branch", "master"})
+		shell.RunCommand([]string{"git", "-C", "../origin", "tag", "v1.0", "master"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.Fetch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fetch summary")).
+			Lines(
+				Contains("--- origin ---"),
+				Contains("-").Contains("origin/branch_to_remove").Contains("[deleted]"),
+				Contains("*").Contains("new_branch → origin/new_branch"),
+				Contains("*").Contains("v1.0 → v1.0"),
+				MatchesRegexp(`^\s*$`),
+				Contains("--- unreachable ---"),
+				Contains("!").Contains("Fetch failed").Contains("does-not-exist"),
+				Contains("Cancel"),
+			)
+	},
+})
@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullMenuFastForwardOnly = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Choose fast-forward only in the pull menu for a diverged branch, and have a plain pull use it too",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↑1↓1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PullMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull 'master'")).
+			Select(Contains("Fast-forward only")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Not possible to fast-forward")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Pull)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Not possible to fast-forward")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("↑1↓1 repo → master"))
+	},
+})
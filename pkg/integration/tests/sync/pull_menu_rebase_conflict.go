@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullMenuRebaseConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Pull with rebase from the pull menu, where a conflict occurs, and see the incoming commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "content2")
+		shell.Commit("two")
+		shell.EmptyCommit("three")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.HardReset("HEAD^^")
+		shell.UpdateFileAndAdd("file", "content4")
+		shell.Commit("four")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↓2 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PullMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull 'master'")).
+			Lines(
+				Contains("Merge").IsSelected(),
+				Contains("Rebase"),
+				Contains("Rebase with autostash"),
+				Contains("Fast-forward only"),
+				Contains("Git config default").Contains("(current)"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Rebase").DoesNotContain("autostash")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Conflicts!")).
+			Lines(
+				Contains("View conflicts").IsSelected(),
+				Contains("Abort the rebase"),
+				Contains("Incoming commits (2)"),
+				Contains("three"),
+				Contains("two"),
+			).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file"),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectNextItem().
+			PressPrimaryAction() // choose 'content4'
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("four"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		// the strategy is remembered for the branch
+		t.GlobalPress(keys.Universal.PullMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Pull 'master'")).
+			Lines(
+				Contains("Merge").IsSelected(),
+				Contains("Rebase").Contains("(current)"),
+				Contains("Rebase with autostash"),
+				Contains("Fast-forward only"),
+				Contains("Git config default"),
+				Contains("Cancel"),
+			)
+
+		t.Git().
+			ConfigValue("branch.master.rebase", "true").
+			ConfigValue("branch.master.lazygitPullStrategy", "rebase")
+	},
+})
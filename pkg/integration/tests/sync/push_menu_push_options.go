@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushMenuPushOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push atomically with a push option from the push menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		// the remote records the push option it receives in our repo
+		shell.RunCommand([]string{"git", "-C", "../origin", "config", "receive.advertisePushOptions", "true"})
+		shell.CreateFile("../origin/hooks/post-receive", "#!/bin/sh\necho \"$GIT_PUSH_OPTION_0\" > ../repo/push-option\n")
+		shell.RunCommand([]string{"chmod", "+x", "../origin/hooks/post-receive"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push")).
+			Select(Contains("Add push option")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Push option")).
+			Type("ci.skip").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push")).
+			Lines(
+				Contains("Push").Contains("master → origin/master"),
+				Contains("Preview (dry run)"),
+				Contains("Remote").Contains("origin"),
+				Contains("Refs to push"),
+				Contains("Add push option").Contains("ci.skip").IsSelected(),
+				Contains("Clear push options"),
+				Contains("[ ] Atomic"),
+				Contains("[ ] Force with lease"),
+				Contains("[ ] Force if includes"),
+				Contains("[ ] Set upstream"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Atomic")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push")).
+			Select(Contains("[x] Atomic")).
+			Select(Contains("Push").Contains("master → origin/master")).
+			Confirm()
+
+		assertSuccessfullyPushed(t)
+
+		t.FileSystem().FileContent("push-option", Equals("ci.skip\n"))
+	},
+})
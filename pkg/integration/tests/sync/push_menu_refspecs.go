@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushMenuRefspecs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Preview and push the current branch to a differently named branch and a tag from the push menu, without changing the upstream",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.PushMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push")).
+			Lines(
+				Contains("Push").Contains("master → origin/master").IsSelected(),
+				Contains("Preview (dry run)"),
+				Contains("Remote").Contains("origin"),
+				Contains("Refs to push"),
+				Contains("Add push option"),
+				Contains("Clear push options"),
+				Contains("[ ] Atomic"),
+				Contains("[ ] Force with lease"),
+				Contains("[ ] Force if includes"),
+				Contains("[ ] Set upstream"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Refs to push")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Refs to push")).
+			Type("master:review v1").
+			SuggestionLines(
+				Contains("v1.0"),
+			).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push")).
+			Lines(
+				Contains("Push").Contains("master → origin/review, v1.0 → origin/v1.0"),
+				Contains("Preview (dry run)"),
+				Contains("Remote").Contains("origin"),
+				Contains("Refs to push").Contains("master:review v1.0").IsSelected(),
+				Contains("Add push option"),
+				Contains("Clear push options"),
+				Contains("[ ] Atomic"),
+				Contains("[ ] Force with lease"),
+				Contains("[ ] Force if includes"),
+				Contains("[ ] Set upstream"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Preview (dry run)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push preview")).
+			Lines(
+				Contains("Push").IsSelected(),
+				Contains("*").Contains("master → review").Contains("[new branch]"),
+				Contains("*").Contains("v1.0 → v1.0").Contains("[new tag]"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		// the upstream hasn't changed
+		t.Views().Status().Content(Contains("↑1 repo → master"))
+
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin"),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("review"),
+			).
+			NavigateToLine(Contains("review")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("two").Contains("v1.0"),
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,99 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CompareWithRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare local tags with the tags of a remote and sync them by pushing and fetching",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateLightweightTag("in-sync", "HEAD")
+		shell.EmptyCommit("two")
+		shell.CreateLightweightTag("moved", "HEAD")
+		shell.CloneIntoRemote("origin")
+
+		shell.RunCommand([]string{"git", "tag", "--force", "moved", "HEAD~1"})
+		shell.CreateLightweightTag("local-only", "HEAD")
+		shell.RunCommand([]string{"git", "-C", "../origin", "tag", "remote-only", "HEAD"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		compareWithOrigin := func() {
+			t.Views().Tags().
+				Focus().
+				Press(keys.Branches.CompareTagsWithRemote)
+
+			t.ExpectPopup().Prompt().
+				Title(Equals("Remote to compare tags with:")).
+				InitialText(Equals("origin")).
+				Confirm()
+		}
+
+		compareWithOrigin()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Lines(
+				Contains("Sync selected tags (0)").IsSelected(),
+				Contains("Toggle all"),
+				Contains("[ ] local-only").Contains("local only"),
+				Contains("[ ] moved").Contains("differs"),
+				Contains("[ ] remote-only").Contains("remote only"),
+				Contains("Cancel"),
+			).
+			Select(Contains("local-only")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Select(Contains("moved")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Select(Contains("Sync selected tags (2)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Lines(
+				Contains("Push to 'origin'").IsSelected(),
+				Contains("Fetch from 'origin'"),
+				Contains("Delete from 'origin'"),
+				Contains("Delete locally"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		compareWithOrigin()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Lines(
+				Contains("Sync selected tags (0)").IsSelected(),
+				Contains("Toggle all"),
+				Contains("[ ] remote-only").Contains("remote only"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Toggle all")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Select(Contains("Sync selected tags (1)")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Tags out of sync with 'origin'")).
+			Select(Contains("Fetch from 'origin'")).
+			Confirm()
+
+		t.Views().Tags().
+			ContainsLines(Contains("remote-only")).
+			LineCount(EqualsInt(4))
+	},
+})
@@ -0,0 +1,38 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowDetails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the tagger, target and signature of an annotated tag, but not of a lightweight tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("lightweight-tag", "HEAD")
+		shell.CreateAnnotatedTag("annotated-tag", "message", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			NavigateToLine(Contains("annotated-tag"))
+
+		t.Views().Secondary().
+			IsVisible().
+			Title(Equals("Tag details")).
+			Content(
+				Contains("Tagger:").Contains("CI <CI@example.com>").
+					Contains("Target:").Contains("commit").
+					Contains("Signature:").Contains("not signed"),
+			)
+
+		t.Views().Tags().
+			NavigateToLine(Contains("lightweight-tag"))
+
+		t.Views().Secondary().
+			IsInvisible()
+	},
+})
@@ -17,9 +17,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_and_search"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/plugins"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/remote"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/submodule"
@@ -36,6 +39,7 @@ var tests = []*components.IntegrationTest{
 	bisect.FromOtherBranch,
 	bisect.Skip,
 	branch.CheckoutByName,
+	branch.CleanUp,
 	branch.CreateTag,
 	branch.Delete,
 	branch.DeleteRemoteBranchWithCredentialPrompt,
@@ -78,6 +82,7 @@ var tests = []*components.IntegrationTest{
 	commit.Reword,
 	commit.Search,
 	commit.SetAuthor,
+	commit.ShowSignatureStatus,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
@@ -90,12 +95,21 @@ var tests = []*components.IntegrationTest{
 	custom_commands.BasicCmdAtRuntime,
 	custom_commands.BasicCmdFromConfig,
 	custom_commands.CheckForConflicts,
+	custom_commands.ChecklistPrompt,
 	custom_commands.ComplexCmdAtRuntime,
+	custom_commands.DisabledWithLineRange,
+	custom_commands.FilePickerPrompt,
 	custom_commands.FormPrompts,
 	custom_commands.MenuFromCommand,
 	custom_commands.MenuFromCommandsOutput,
+	custom_commands.MultilineInputPrompt,
 	custom_commands.MultiplePrompts,
 	custom_commands.OmitFromHistory,
+	custom_commands.OutputMenu,
+	custom_commands.SelectedFilesAndModels,
+	custom_commands.Steps,
+	custom_commands.StepsAbortOnFailure,
+	custom_commands.StepsSkipConditional,
 	custom_commands.SuggestionsCommand,
 	custom_commands.SuggestionsPreset,
 	demo.AmendOldCommit,
@@ -116,7 +130,10 @@ var tests = []*components.IntegrationTest{
 	diff.Diff,
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
+	diff.DiffOptions,
 	diff.IgnoreWhitespace,
+	diff.SideBySide,
+	diff.WordDiff,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
 	file.DiscardAllDirChanges,
@@ -125,6 +142,8 @@ var tests = []*components.IntegrationTest{
 	file.DiscardUnstagedDirChanges,
 	file.DiscardUnstagedFileChanges,
 	file.Gitignore,
+	file.LfsFiles,
+	file.RecoverDiscardedChanges,
 	file.RememberCommitMessageAfterFail,
 	filter_and_search.FilterCommitFiles,
 	filter_and_search.FilterFiles,
@@ -171,6 +190,11 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SwapInRebaseWithConflict,
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
+	keybindings.Chord,
+	keybindings.ChordConflict,
+	keybindings.ConflictsMenu,
+	keybindings.NestedChord,
+	misc.AuditLog,
 	misc.ConfirmOnQuit,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
@@ -194,11 +218,19 @@ var tests = []*components.IntegrationTest{
 	patch_building.SelectAllFiles,
 	patch_building.SpecificSelection,
 	patch_building.StartNewPatch,
+	plugins.Keybinding,
+	plugins.MenuAndPopups,
+	plugins.MenuCancelled,
 	reflog.Checkout,
 	reflog.CherryPick,
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
 	reflog.Patch,
+	reflog.RecoverDroppedStash,
+	reflog.RecoverFromBranchReflog,
 	reflog.Reset,
+	remote.CleanUpMergedBranches,
+	remote.EditPushUrls,
+	remote.Prune,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
 	staging.Search,
@@ -223,11 +255,14 @@ var tests = []*components.IntegrationTest{
 	submodule.Remove,
 	submodule.Reset,
 	sync.FetchPrune,
+	sync.FetchSummary,
 	sync.ForcePush,
 	sync.ForcePushMultipleMatching,
 	sync.ForcePushMultipleUpstream,
 	sync.Pull,
 	sync.PullAndSetUpstream,
+	sync.PullMenuFastForwardOnly,
+	sync.PullMenuRebaseConflict,
 	sync.PullMerge,
 	sync.PullMergeConflict,
 	sync.PullRebase,
@@ -238,23 +273,31 @@ var tests = []*components.IntegrationTest{
 	sync.PushAndAutoSetUpstream,
 	sync.PushAndSetUpstream,
 	sync.PushFollowTags,
+	sync.PushMenuPushOptions,
+	sync.PushMenuRefspecs,
 	sync.PushNoFollowTags,
 	sync.PushTag,
 	sync.PushWithCredentialPrompt,
 	sync.RenameBranchAndPull,
 	tag.Checkout,
+	tag.CompareWithRemote,
 	tag.CreateWhileCommitting,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
 	tag.Reset,
+	tag.ShowDetails,
 	ui.Accordion,
+	ui.CommandPalette,
 	ui.DoublePopup,
 	ui.EmptyMenu,
 	ui.OpenLinkFailure,
 	ui.SwitchTabFromMenu,
+	ui.SwitchTheme,
+	undo.UndoBranchDeleteAndDrop,
 	undo.UndoCheckoutAndDrop,
+	undo.UndoDiscardFileChanges,
 	undo.UndoDrop,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandPalette = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Find an item of another panel's menu in the command palette and run it in that panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "content1")
+		shell.Commit("one")
+		shell.CreateFileAndAdd("file2", "content2")
+		shell.Commit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("one"))
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.OpenCommandPalette)
+
+		t.ExpectSearch().
+			Type("soft reset commits").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command palette")).
+			TopLines(
+				Contains("g s").Contains("View reset options > Soft reset").Contains("Commits").IsSelected(),
+			).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("one").IsSelected(),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("A  file2"),
+			)
+	},
+})
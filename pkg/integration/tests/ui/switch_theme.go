@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SwitchTheme = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch to a bundled theme from the status panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo:    func(shell *Shell) {},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Themes")).
+			Lines(
+				Contains("default").Contains("(current)").IsSelected(),
+				Contains("colorblind").DoesNotContain("(current)"),
+				Contains("dark").DoesNotContain("(current)"),
+				Contains("high-contrast").DoesNotContain("(current)"),
+				Contains("light").DoesNotContain("(current)"),
+				Contains("solarized").DoesNotContain("(current)"),
+				Contains("Cancel"),
+			).
+			Select(Contains("light")).
+			Confirm()
+
+		t.Views().Status().
+			IsFocused().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Themes")).
+			Lines(
+				Contains("default").DoesNotContain("(current)").IsSelected(),
+				Contains("colorblind").DoesNotContain("(current)"),
+				Contains("dark").DoesNotContain("(current)"),
+				Contains("high-contrast").DoesNotContain("(current)"),
+				Contains("light").Contains("(current)"),
+				Contains("solarized").DoesNotContain("(current)"),
+				Contains("Cancel"),
+			)
+	},
+})
@@ -0,0 +1,118 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoBranchDeleteAndDrop = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete a branch and drop a commit, then undo and redo both in the right order",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature commit")
+		shell.Checkout("master")
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete branch 'feature'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Force delete branch")).
+					Content(Equals("'feature' is not fully merged. Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Delete commit")).
+					Content(Equals("Are you sure you want to delete this commit?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("one").IsSelected(),
+			)
+
+		// the commit was dropped last, so it's restored first
+		t.GlobalPress(keys.Universal.Undo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Undo")).
+			Content(Contains("hard reset")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+			)
+
+		t.GlobalPress(keys.Universal.Undo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Undo")).
+			Content(Equals("Are you sure you want to undo 'Delete local branch: feature'?")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("feature"),
+			)
+
+		// there's nothing left to undo, so the branch is deleted again first
+		t.GlobalPress(keys.Universal.Redo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Redo")).
+			Content(Equals("Are you sure you want to redo 'Delete local branch: feature'?")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+			)
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.GlobalPress(keys.Universal.Redo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Redo")).
+			Content(Contains("hard reset")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,79 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscardFileChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard the changes in a file and an untracked file, then undo and redo that",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "original content\n")
+		shell.Commit("one")
+		shell.UpdateFile("file", "original content\nnew content\n")
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		discard := func(path string) {
+			t.ExpectPopup().Menu().
+				Title(Equals(path)).
+				Select(Contains("Discard all changes")).
+				Confirm()
+		}
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Contains("file").IsSelected(),
+				Contains("untracked"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() { discard("file") }).
+			Lines(
+				Contains("untracked").IsSelected(),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() { discard("untracked") }).
+			IsEmpty()
+
+		t.GlobalPress(keys.Universal.Undo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Undo")).
+			Content(Equals("Are you sure you want to undo 'Discard all changes in file: untracked'?")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("untracked"),
+			)
+		t.FileSystem().FileContent("untracked", Equals("untracked content\n"))
+
+		t.GlobalPress(keys.Universal.Undo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Undo")).
+			Content(Equals("Are you sure you want to undo 'Discard all changes in file: file'?")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains(" M").Contains("file"),
+				Contains("untracked"),
+			)
+		t.FileSystem().FileContent("file", Equals("original content\nnew content\n"))
+
+		t.GlobalPress(keys.Universal.Redo)
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Redo")).
+			Content(Equals("Are you sure you want to redo 'Discard all changes in file: file'?")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("untracked"),
+			)
+		t.FileSystem().FileContent("file", Equals("original content\n"))
+	},
+})
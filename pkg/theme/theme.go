@@ -4,6 +4,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
 )
 
 var (
@@ -44,6 +45,35 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	StagedChangesColor = style.FgGreen
+
+	PartiallyStagedChangesColor = style.FgYellow
+
+	// The colors of the file statuses in the commit files view
+	AddedFileColor       = style.FgGreen
+	ModifiedFileColor    = style.FgYellow
+	DeletedFileColor     = style.FgRed
+	CopiedFileColor      = style.FgCyan
+	TypeChangedFileColor = style.FgMagenta
+
+	// The colors of diffs rendered by lazygit itself, i.e. in the staging and
+	// patch building views
+	DiffHeaderColor      = style.FgDefault.SetBold()
+	DiffHunkHeaderColor  = style.FgCyan
+	DiffAddedLineColor   = style.FgGreen
+	DiffRemovedLineColor = style.FgRed
+
+	// If non-empty, author colors are picked from these instead of generated
+	AuthorColorPalette []style.TextStyle
+
+	// If non-empty, the colors of the commit graph are picked from these
+	// instead of using the author colors
+	GraphColorPalette []style.TextStyle
+
+	// If true, symbols are added wherever a distinction is otherwise only shown
+	// by color, see gui.accessibleMode
+	AccessibleMode = false
 )
 
 // UpdateTheme updates all theme variables
@@ -71,4 +101,27 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 
 	DefaultTextColor = GetTextStyle(themeConfig.DefaultFgColor, false)
 	GocuiDefaultTextColor = GetGocuiStyle(themeConfig.DefaultFgColor)
+
+	StagedChangesColor = GetTextStyle(themeConfig.StagedChangesColor, false)
+	PartiallyStagedChangesColor = GetTextStyle(themeConfig.PartiallyStagedChangesColor, false)
+
+	AddedFileColor = GetTextStyle(themeConfig.AddedFileColor, false)
+	ModifiedFileColor = GetTextStyle(themeConfig.ModifiedFileColor, false)
+	DeletedFileColor = GetTextStyle(themeConfig.DeletedFileColor, false)
+	CopiedFileColor = GetTextStyle(themeConfig.CopiedFileColor, false)
+	TypeChangedFileColor = GetTextStyle(themeConfig.TypeChangedFileColor, false)
+
+	DiffHeaderColor = DefaultTextColor.MergeStyle(GetTextStyle(themeConfig.DiffHeaderColor, false))
+	DiffHunkHeaderColor = GetTextStyle(themeConfig.DiffHunkHeaderColor, false)
+	DiffAddedLineColor = GetTextStyle(themeConfig.DiffAddedLineColor, false)
+	DiffRemovedLineColor = GetTextStyle(themeConfig.DiffRemovedLineColor, false)
+
+	AuthorColorPalette = getPalette(themeConfig.AuthorColorPalette)
+	GraphColorPalette = getPalette(themeConfig.GraphColorPalette)
+}
+
+func getPalette(colors []string) []style.TextStyle {
+	return lo.Map(colors, func(color string, _ int) style.TextStyle {
+		return GetTextStyle([]string{color}, false)
+	})
 }
@@ -0,0 +1,101 @@
+package theme
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	yaml "github.com/jesseduffield/yaml"
+	"github.com/samber/lo"
+)
+
+//go:embed themes/*.yml
+var bundledThemes embed.FS
+
+// The folder in the config dir that user themes are loaded from
+const ThemesDirName = "themes"
+
+// The name for the colors configured in gui.theme, without any theme applied
+const DefaultThemeName = "default"
+
+// The bundled theme that's used in accessible mode unless another one is picked
+const ColorblindThemeName = "colorblind"
+
+var themeFileExtensions = []string{".yml", ".yaml"}
+
+// Returns the default theme name followed by the names of the bundled themes
+// and of the theme files in the config dir, sorted by name
+func Names(configDir string) []string {
+	names := []string{}
+
+	entries, _ := bundledThemes.ReadDir("themes")
+	for _, entry := range entries {
+		names = append(names, themeName(entry.Name()))
+	}
+
+	entries, _ = os.ReadDir(filepath.Join(configDir, ThemesDirName))
+	for _, entry := range entries {
+		if !entry.IsDir() && lo.Contains(themeFileExtensions, filepath.Ext(entry.Name())) {
+			names = append(names, themeName(entry.Name()))
+		}
+	}
+
+	names = lo.Without(lo.Uniq(names), DefaultThemeName)
+	sort.Strings(names)
+	return append([]string{DefaultThemeName}, names...)
+}
+
+// Returns the theme with the given name applied on top of base, so that a
+// theme only needs to specify the colors it changes. A theme file in the config
+// dir takes precedence over a bundled theme with the same name. The default
+// theme returns base unchanged.
+func Load(name string, base config.ThemeConfig, configDir string) (config.ThemeConfig, error) {
+	if name == DefaultThemeName {
+		return base, nil
+	}
+
+	content, err := readTheme(name, configDir)
+	if err != nil {
+		return base, err
+	}
+
+	result := base
+	if err := yaml.Unmarshal(content, &result); err != nil {
+		return base, fmt.Errorf("The theme '%s' couldn't be parsed: %w", name, err)
+	}
+
+	return result, nil
+}
+
+func readTheme(name string, configDir string) ([]byte, error) {
+	// the name comes from the config or the app state, and mustn't point
+	// outside the themes folder
+	if strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("Invalid theme name '%s': theme names can't contain path separators", name)
+	}
+
+	for _, extension := range themeFileExtensions {
+		content, err := os.ReadFile(filepath.Join(configDir, ThemesDirName, name+extension))
+		if err == nil {
+			return content, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	content, err := bundledThemes.ReadFile("themes/" + name + ".yml")
+	if err != nil {
+		return nil, fmt.Errorf("Unknown theme '%s'. Available themes: %s", name, strings.Join(Names(configDir), ", "))
+	}
+
+	return content, nil
+}
+
+func themeName(fileName string) string {
+	return strings.TrimSuffix(fileName, filepath.Ext(fileName))
+}
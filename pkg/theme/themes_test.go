@@ -0,0 +1,151 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNames(t *testing.T) {
+	configDir := t.TempDir()
+	writeThemeFile(t, configDir, "mine.yml", "activeBorderColor: [red]")
+	writeThemeFile(t, configDir, "other.yaml", "activeBorderColor: [red]")
+	writeThemeFile(t, configDir, "light.yml", "activeBorderColor: [red]")
+	writeThemeFile(t, configDir, "notes.txt", "not a theme")
+
+	assert.Equal(t,
+		[]string{"default", "colorblind", "dark", "high-contrast", "light", "mine", "other", "solarized"},
+		Names(configDir),
+	)
+}
+
+func TestLoad(t *testing.T) {
+	configDir := t.TempDir()
+	writeThemeFile(t, configDir, "mine.yml", "activeBorderColor: [red, bold]\ngraphColorPalette: [blue, cyan]")
+	writeThemeFile(t, configDir, "solarized.yaml", "activeBorderColor: [magenta]")
+	writeThemeFile(t, configDir, "broken.yml", "activeBorderColor: [red")
+
+	base := config.GetDefaultConfig().Gui.Theme
+
+	scenarios := []struct {
+		name          string
+		themeName     string
+		test          func(config.ThemeConfig)
+		expectedError string
+	}{
+		{
+			name:      "default theme",
+			themeName: "default",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, base, result)
+			},
+		},
+		{
+			name:      "theme file only overrides the colors it sets",
+			themeName: "mine",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, []string{"red", "bold"}, result.ActiveBorderColor)
+				assert.Equal(t, []string{"blue", "cyan"}, result.GraphColorPalette)
+				assert.Equal(t, base.InactiveBorderColor, result.InactiveBorderColor)
+				assert.Equal(t, base.DiffAddedLineColor, result.DiffAddedLineColor)
+			},
+		},
+		{
+			name:      "bundled theme",
+			themeName: "light",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, []string{"blue", "bold"}, result.ActiveBorderColor)
+				assert.Equal(t, []string{"black"}, result.DefaultFgColor)
+				assert.NotEmpty(t, result.AuthorColorPalette)
+			},
+		},
+		{
+			name:      "theme file takes precedence over bundled theme",
+			themeName: "solarized",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, []string{"magenta"}, result.ActiveBorderColor)
+				assert.Equal(t, base.SelectedLineBgColor, result.SelectedLineBgColor)
+			},
+		},
+		{
+			name:          "unknown theme",
+			themeName:     "nope",
+			expectedError: "Unknown theme 'nope'. Available themes: default, broken, colorblind, dark, high-contrast, light, mine, solarized",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, base, result)
+			},
+		},
+		{
+			name:          "theme name with path separator",
+			themeName:     "../mine",
+			expectedError: "Invalid theme name '../mine'",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, base, result)
+			},
+		},
+		{
+			name:          "invalid theme file",
+			themeName:     "broken",
+			expectedError: "The theme 'broken' couldn't be parsed",
+			test: func(result config.ThemeConfig) {
+				assert.Equal(t, base, result)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			result, err := Load(s.themeName, base, configDir)
+			if s.expectedError != "" {
+				assert.ErrorContains(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			s.test(result)
+		})
+	}
+}
+
+// The bundled themes should set every color, so that they look the same
+// whatever is configured in gui.theme
+func TestBundledThemesAreComplete(t *testing.T) {
+	var empty config.ThemeConfig
+	for _, name := range Names(t.TempDir()) {
+		if name == DefaultThemeName {
+			continue
+		}
+
+		result, err := Load(name, empty, t.TempDir())
+		assert.NoError(t, err)
+
+		value := reflect.ValueOf(result)
+		for i := 0; i < value.NumField(); i++ {
+			assert.NotEmpty(t, value.Field(i).Interface(), "%s doesn't set %s", name, value.Type().Field(i).Name)
+		}
+	}
+}
+
+// Red and green are what the most common forms of color blindness can't tell
+// apart, so the colorblind theme shouldn't rely on them
+func TestColorblindThemeAvoidsRedAndGreen(t *testing.T) {
+	result, err := Load("colorblind", config.ThemeConfig{}, t.TempDir())
+	assert.NoError(t, err)
+
+	value := reflect.ValueOf(result)
+	for i := 0; i < value.NumField(); i++ {
+		for _, color := range value.Field(i).Interface().([]string) {
+			assert.NotContains(t, []string{"red", "green"}, color, value.Type().Field(i).Name)
+		}
+	}
+}
+
+func writeThemeFile(t *testing.T, configDir string, fileName string, content string) {
+	dir := filepath.Join(configDir, ThemesDirName)
+	assert.NoError(t, os.MkdirAll(dir, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, fileName), []byte(content), 0o644))
+}
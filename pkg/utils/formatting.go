@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/mattn/go-runewidth"
@@ -174,6 +175,23 @@ func SafeTruncate(str string, limit int) string {
 	}
 }
 
+// FormatFileSize formats a number of bytes the way git-lfs does, with decimal
+// units and one digit after the point, e.g. '1.5 MB'
+func FormatFileSize(size int64) string {
+	if size < 1000 {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	value := float64(size)
+	for _, unit := range []string{"KB", "MB", "GB", "TB"} {
+		value /= 1000
+		if value < 999.95 || unit == "TB" {
+			return fmt.Sprintf("%.1f %s", value, unit)
+		}
+	}
+	return ""
+}
+
 const COMMIT_HASH_SHORT_SIZE = 8
 
 func ShortSha(sha string) string {
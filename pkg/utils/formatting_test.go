@@ -156,6 +156,27 @@ func TestTruncateWithEllipsis(t *testing.T) {
 	}
 }
 
+func TestFormatFileSize(t *testing.T) {
+	scenarios := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{999, "999 B"},
+		{1000, "1.0 KB"},
+		{1536, "1.5 KB"},
+		{999_949, "999.9 KB"},
+		{999_950, "1.0 MB"},
+		{52_400_000, "52.4 MB"},
+		{3_000_000_000, "3.0 GB"},
+		{4_000_000_000_000_000, "4000.0 TB"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, FormatFileSize(s.size))
+	}
+}
+
 func TestRenderDisplayStrings(t *testing.T) {
 	type scenario struct {
 		input                   [][]string